@@ -0,0 +1,55 @@
+package nmea
+
+const (
+	// TypeAAM type for AAM sentences
+	TypeAAM = "AAM"
+	// ArrivedAAM arrival circle entered or perpendicular passed
+	ArrivedAAM = "A"
+	// NotArrivedAAM arrival circle not entered or perpendicular not passed
+	NotArrivedAAM = "V"
+	// NauticalMilesAAM arrival circle radius unit
+	NauticalMilesAAM = "N"
+)
+
+// AAM is the waypoint arrival alarm.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_aam_waypoint_arrival_alarm
+type AAM struct {
+	BaseSentence
+	StatusArrivalCircleEntered string  // A = arrival circle entered, V = not entered
+	StatusPerpendicularPassed  string  // A = perpendicular passed at the waypoint, V = not passed
+	ArrivalCircleRadius        float64 // Arrival circle radius
+	RadiusUnits                string  // N = nautical miles
+	WaypointID                 string  // Waypoint ID
+}
+
+func (s AAM) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"status_arrival_circle_entered": s.StatusArrivalCircleEntered,
+		"status_perpendicular_passed":   s.StatusPerpendicularPassed,
+		"arrival_circle_radius":         s.ArrivalCircleRadius,
+		"radius_units":                  s.RadiusUnits,
+		"waypoint_id":                   s.WaypointID,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newAAM constructor
+func newAAM(s BaseSentence) (AAM, error) {
+	p := NewParser(s)
+	p.AssertType(TypeAAM)
+	return AAM{
+		BaseSentence:               s,
+		StatusArrivalCircleEntered: p.EnumString(0, "arrival circle entered status", ArrivedAAM, NotArrivedAAM),
+		StatusPerpendicularPassed:  p.EnumString(1, "perpendicular passed status", ArrivedAAM, NotArrivedAAM),
+		ArrivalCircleRadius:        p.Float64(2, "arrival circle radius"),
+		RadiusUnits:                p.EnumString(3, "arrival circle radius units", NauticalMilesAAM),
+		WaypointID:                 p.String(4, "waypoint ID"),
+	}, p.Err()
+}
@@ -0,0 +1,53 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var aamtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  AAM
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPAAM,A,A,0.10,N,WPTNME*32",
+		msg: AAM{
+			StatusArrivalCircleEntered: ArrivedAAM,
+			StatusPerpendicularPassed:  ArrivedAAM,
+			ArrivalCircleRadius:        0.1,
+			RadiusUnits:                NauticalMilesAAM,
+			WaypointID:                 "WPTNME",
+		},
+	},
+	{
+		name: "bad arrival circle entered status",
+		raw:  "$GPAAM,X,A,0.10,N,WPTNME*2B",
+		err:  "nmea: GPAAM invalid arrival circle entered status: X",
+	},
+	{
+		name: "bad perpendicular passed status",
+		raw:  "$GPAAM,A,X,0.10,N,WPTNME*2B",
+		err:  "nmea: GPAAM invalid perpendicular passed status: X",
+	},
+}
+
+func TestAAM(t *testing.T) {
+	for _, tt := range aamtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				aam := m.(AAM)
+				aam.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, aam)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// AISRateOfTurnNotAvailable is the encoded rate of turn when no turn information is available.
+	AISRateOfTurnNotAvailable = -128
+	// AISRateOfTurnRight is the encoded rate of turn when turning right faster than 5° per 30s without a turn indicator.
+	AISRateOfTurnRight = 127
+	// AISRateOfTurnLeft is the encoded rate of turn when turning left faster than 5° per 30s without a turn indicator.
+	AISRateOfTurnLeft = -127
+)
+
+// AISPositionReport is the class A position report carried by AIS message types 1, 2 and 3.
+// http://catb.org/gpsd/AIVDM.html#_types_1_2_and_3_position_report_class_a
+type AISPositionReport struct {
+	MessageType       int64   // Message type 1, 2 or 3
+	Repeat            int64   // Repeat indicator
+	MMSI              int64   // Maritime mobile service identity
+	NavigationStatus  int64   // Navigation status
+	RateOfTurn        int64   // Encoded rate of turn (ROT_AIS), see RateOfTurnDegPerMin
+	SpeedOverGround   float64 // Speed over ground in knots
+	PositionAccuracy  bool    // Position accuracy better than 10m
+	Longitude         float64 // Longitude in degrees
+	Latitude          float64 // Latitude in degrees
+	CourseOverGround  float64 // Course over ground in degrees
+	TrueHeading       int64   // True heading in degrees, 511 when not available
+	Timestamp         int64   // Second of UTC timestamp
+	ManeuverIndicator int64   // Maneuver indicator
+	RAIM              bool    // Receiver autonomous integrity monitoring in use
+	RadioStatus       int64   // Communication state
+}
+
+// RateOfTurnDegPerMin converts the encoded rate of turn into degrees per minute,
+// positive when turning right. ok is false when the exact rate is unknown:
+// for AISRateOfTurnNotAvailable the returned rate is 0, and for AISRateOfTurnRight
+// and AISRateOfTurnLeft it is the ±10°/min (5° per 30s) lower bound of the turn.
+func (r AISPositionReport) RateOfTurnDegPerMin() (rate float64, ok bool) {
+	switch r.RateOfTurn {
+	case AISRateOfTurnNotAvailable:
+		return 0, false
+	case AISRateOfTurnRight:
+		return 10, false
+	case AISRateOfTurnLeft:
+		return -10, false
+	}
+	v := float64(r.RateOfTurn) / 4.733
+	return math.Copysign(v*v, v), true
+}
+
+// DecodePositionReport decodes the payload as an AIS position report (types 1, 2 and 3).
+func (s VDMVDO) DecodePositionReport() (AISPositionReport, error) {
+	if len(s.Payload) < 168 {
+		return AISPositionReport{}, fmt.Errorf("nmea: %s invalid position report: payload too short", s.Prefix())
+	}
+	r := AISPositionReport{
+		MessageType:       aisUint(s.Payload, 0, 6),
+		Repeat:            aisUint(s.Payload, 6, 2),
+		MMSI:              aisUint(s.Payload, 8, 30),
+		NavigationStatus:  aisUint(s.Payload, 38, 4),
+		RateOfTurn:        aisInt(s.Payload, 42, 8),
+		SpeedOverGround:   float64(aisUint(s.Payload, 50, 10)) / 10,
+		PositionAccuracy:  aisUint(s.Payload, 60, 1) == 1,
+		Longitude:         float64(aisInt(s.Payload, 61, 28)) / 600000,
+		Latitude:          float64(aisInt(s.Payload, 89, 27)) / 600000,
+		CourseOverGround:  float64(aisUint(s.Payload, 116, 12)) / 10,
+		TrueHeading:       aisUint(s.Payload, 128, 9),
+		Timestamp:         aisUint(s.Payload, 137, 6),
+		ManeuverIndicator: aisUint(s.Payload, 143, 2),
+		RAIM:              aisUint(s.Payload, 148, 1) == 1,
+		RadioStatus:       aisUint(s.Payload, 149, 19),
+	}
+	if r.MessageType < 1 || r.MessageType > 3 {
+		return r, fmt.Errorf("nmea: %s invalid position report: message type %d", s.Prefix(), r.MessageType)
+	}
+	return r, nil
+}
+
+// AISBaseStationReport is the base station report carried by AIS message type 4.
+// Message type 11 (UTC/date response) shares the same layout.
+// http://catb.org/gpsd/AIVDM.html#_type_4_base_station_report
+type AISBaseStationReport struct {
+	MessageType      int64   // Message type 4 or 11
+	Repeat           int64   // Repeat indicator
+	MMSI             int64   // Maritime mobile service identity
+	Year             int64   // UTC year, 0 when not available
+	Month            int64   // UTC month, 0 when not available
+	Day              int64   // UTC day, 0 when not available
+	Hour             int64   // UTC hour, 24 when not available
+	Minute           int64   // UTC minute, 60 when not available
+	Second           int64   // UTC second, 60 when not available
+	PositionAccuracy bool    // Position accuracy better than 10m
+	Longitude        float64 // Longitude in degrees
+	Latitude         float64 // Latitude in degrees
+	EPFD             int64   // Type of electronic position fixing device
+	RAIM             bool    // Receiver autonomous integrity monitoring in use
+	RadioStatus      int64   // Communication state
+}
+
+// DecodeBaseStationReport decodes the payload as an AIS base station report (type 4).
+func (s VDMVDO) DecodeBaseStationReport() (AISBaseStationReport, error) {
+	return s.decodeBaseStationReport("base station report", 4)
+}
+
+// DecodeUTCDateResponse decodes the payload as an AIS UTC/date response (type 11).
+func (s VDMVDO) DecodeUTCDateResponse() (AISBaseStationReport, error) {
+	return s.decodeBaseStationReport("utc/date response", 11)
+}
+
+// decodeBaseStationReport decodes the type 4 layout shared by message types 4 and 11.
+func (s VDMVDO) decodeBaseStationReport(context string, messageType int64) (AISBaseStationReport, error) {
+	if len(s.Payload) < 168 {
+		return AISBaseStationReport{}, fmt.Errorf("nmea: %s invalid %s: payload too short", s.Prefix(), context)
+	}
+	r := AISBaseStationReport{
+		MessageType:      aisUint(s.Payload, 0, 6),
+		Repeat:           aisUint(s.Payload, 6, 2),
+		MMSI:             aisUint(s.Payload, 8, 30),
+		Year:             aisUint(s.Payload, 38, 14),
+		Month:            aisUint(s.Payload, 52, 4),
+		Day:              aisUint(s.Payload, 56, 5),
+		Hour:             aisUint(s.Payload, 61, 5),
+		Minute:           aisUint(s.Payload, 66, 6),
+		Second:           aisUint(s.Payload, 72, 6),
+		PositionAccuracy: aisUint(s.Payload, 78, 1) == 1,
+		Longitude:        float64(aisInt(s.Payload, 79, 28)) / 600000,
+		Latitude:         float64(aisInt(s.Payload, 107, 27)) / 600000,
+		EPFD:             aisUint(s.Payload, 134, 4),
+		RAIM:             aisUint(s.Payload, 148, 1) == 1,
+		RadioStatus:      aisUint(s.Payload, 149, 19),
+	}
+	if r.MessageType != messageType {
+		return r, fmt.Errorf("nmea: %s invalid %s: message type %d", s.Prefix(), context, r.MessageType)
+	}
+	return r, nil
+}
+
+// aisUint reads an unsigned integer of the given bit length from a
+// payload holding one bit per byte.
+func aisUint(bits []byte, start, length int) int64 {
+	var v int64
+	for _, b := range bits[start : start+length] {
+		v = v<<1 | int64(b)
+	}
+	return v
+}
+
+// aisInt reads a two's complement signed integer of the given bit length
+// from a payload holding one bit per byte.
+func aisInt(bits []byte, start, length int) int64 {
+	v := aisUint(bits, start, length)
+	if bits[start] == 1 {
+		v -= 1 << uint(length)
+	}
+	return v
+}
+
+// AISCommunicationState is the decoded communication state of a position report.
+// Message types 1 and 2 use the SOTDMA layout and type 3 the ITDMA layout.
+// http://catb.org/gpsd/AIVDM.html#_types_1_2_and_3_position_report_class_a
+type AISCommunicationState struct {
+	SOTDMA    bool  // SOTDMA layout, ITDMA otherwise
+	SyncState int64 // Synchronization state, 0 = UTC direct, 1 = UTC indirect, 2 = base station, 3 = other station
+
+	// SOTDMA fields
+	SlotTimeout      int64 // Frames remaining until a new slot is selected
+	SubMessage       int64 // Raw sub message, interpreted according to SlotTimeout
+	ReceivedStations int64 // Number of stations received, for slot timeout 3, 5 and 7
+	SlotNumber       int64 // Slot number used for transmission, for slot timeout 2, 4 and 6
+	UTCHour          int64 // UTC hour, for slot timeout 1
+	UTCMinute        int64 // UTC minute, for slot timeout 1
+	SlotOffset       int64 // Offset to the next slot, for slot timeout 0
+
+	// ITDMA fields
+	SlotIncrement int64 // Offset to the next slot to be used
+	NumberOfSlots int64 // Number of consecutive slots to allocate
+	KeepFlag      bool  // Keep the slot allocation for one more frame
+}
+
+// CommunicationStateDetail decodes the RadioStatus field of the report.
+func (r AISPositionReport) CommunicationStateDetail() AISCommunicationState {
+	v := r.RadioStatus
+	c := AISCommunicationState{
+		SOTDMA:    r.MessageType != 3,
+		SyncState: v >> 17 & 0x3,
+	}
+	if !c.SOTDMA {
+		c.SlotIncrement = v >> 4 & 0x1FFF
+		c.NumberOfSlots = v >> 1 & 0x7
+		c.KeepFlag = v&0x1 == 1
+		return c
+	}
+	c.SlotTimeout = v >> 14 & 0x7
+	c.SubMessage = v & 0x3FFF
+	switch c.SlotTimeout {
+	case 3, 5, 7:
+		c.ReceivedStations = c.SubMessage
+	case 2, 4, 6:
+		c.SlotNumber = c.SubMessage
+	case 1:
+		c.UTCHour = c.SubMessage >> 9 & 0x1F
+		c.UTCMinute = c.SubMessage >> 2 & 0x7F
+	case 0:
+		c.SlotOffset = c.SubMessage
+	}
+	return c
+}
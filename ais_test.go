@@ -0,0 +1,188 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodePositionReport(t *testing.T) {
+	vdm := mustParseVDMVDO(t, "!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55")
+	r, err := vdm.DecodePositionReport()
+	assert.NoError(t, err)
+	assert.Equal(t, AISPositionReport{
+		MessageType:       1,
+		MMSI:              244710402,
+		RateOfTurn:        AISRateOfTurnNotAvailable,
+		SpeedOverGround:   5,
+		PositionAccuracy:  true,
+		Longitude:         3965239.0 / 600000,
+		Latitude:          30940057.0 / 600000,
+		CourseOverGround:  113,
+		TrueHeading:       511,
+		Timestamp:         55,
+		ManeuverIndicator: 1,
+		RAIM:              true,
+		RadioStatus:       59916,
+	}, r)
+}
+
+func TestDecodePositionReportErrors(t *testing.T) {
+	vdm := mustParseVDMVDO(t, "!AIVDM,1,1,,A,H77nSfPh4U=<E`H4U8G;:222220,2*1F")
+	_, err := vdm.DecodePositionReport()
+	assert.EqualError(t, err, "nmea: AIVDM invalid position report: payload too short")
+
+	vdm = mustParseVDMVDO(t, "!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55")
+	vdm.Payload[3] = 1 // message type 5
+	_, err = vdm.DecodePositionReport()
+	assert.EqualError(t, err, "nmea: AIVDM invalid position report: message type 5")
+}
+
+func TestRateOfTurnDegPerMin(t *testing.T) {
+	tests := []struct {
+		encoded int64
+		rate    float64
+		ok      bool
+	}{
+		{0, 0, true},
+		{10, 4.4640, true},
+		{-20, -17.8561, true},
+		{126, 708.7092, true},
+		{-126, -708.7092, true},
+		{AISRateOfTurnRight, 10, false},
+		{AISRateOfTurnLeft, -10, false},
+		{AISRateOfTurnNotAvailable, 0, false},
+	}
+	for _, tt := range tests {
+		rate, ok := AISPositionReport{RateOfTurn: tt.encoded}.RateOfTurnDegPerMin()
+		assert.InDelta(t, tt.rate, rate, 0.0001, "encoded %d", tt.encoded)
+		assert.Equal(t, tt.ok, ok, "encoded %d", tt.encoded)
+	}
+}
+
+func TestCommunicationStateDetail(t *testing.T) {
+	vdm := mustParseVDMVDO(t, "!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55")
+	r, err := vdm.DecodePositionReport()
+	assert.NoError(t, err)
+	assert.Equal(t, AISCommunicationState{
+		SOTDMA:           true,
+		SlotTimeout:      3,
+		SubMessage:       10764,
+		ReceivedStations: 10764,
+	}, r.CommunicationStateDetail())
+
+	tests := []struct {
+		name   string
+		report AISPositionReport
+		state  AISCommunicationState
+	}{
+		{
+			name:   "sotdma utc time",
+			report: AISPositionReport{MessageType: 2, RadioStatus: 154292},
+			state: AISCommunicationState{
+				SOTDMA:      true,
+				SyncState:   1,
+				SlotTimeout: 1,
+				SubMessage:  6836,
+				UTCHour:     13,
+				UTCMinute:   45,
+			},
+		},
+		{
+			name:   "sotdma slot number",
+			report: AISPositionReport{MessageType: 1, RadioStatus: 296959},
+			state: AISCommunicationState{
+				SOTDMA:      true,
+				SyncState:   2,
+				SlotTimeout: 2,
+				SubMessage:  2047,
+				SlotNumber:  2047,
+			},
+		},
+		{
+			name:   "sotdma slot offset",
+			report: AISPositionReport{MessageType: 1, RadioStatus: 5},
+			state: AISCommunicationState{
+				SOTDMA:     true,
+				SubMessage: 5,
+				SlotOffset: 5,
+			},
+		},
+		{
+			name:   "itdma",
+			report: AISPositionReport{MessageType: 3, RadioStatus: 59916},
+			state: AISCommunicationState{
+				SlotIncrement: 3744,
+				NumberOfSlots: 6,
+			},
+		},
+		{
+			name:   "itdma keep flag",
+			report: AISPositionReport{MessageType: 3, RadioStatus: 3<<17 | 100<<4 | 2<<1 | 1},
+			state: AISCommunicationState{
+				SyncState:     3,
+				SlotIncrement: 100,
+				NumberOfSlots: 2,
+				KeepFlag:      true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.state, tt.report.CommunicationStateDetail())
+		})
+	}
+}
+
+func TestDecodeBaseStationReport(t *testing.T) {
+	vdm := mustParseVDMVDO(t, "!AIVDM,1,1,,A,403OviQuMGCqWrRO9>E6fE700@GO,0*4D")
+	r, err := vdm.DecodeBaseStationReport()
+	assert.NoError(t, err)
+	assert.Equal(t, AISBaseStationReport{
+		MessageType:      4,
+		MMSI:             3669702,
+		Year:             2007,
+		Month:            5,
+		Day:              14,
+		Hour:             19,
+		Minute:           57,
+		Second:           39,
+		PositionAccuracy: true,
+		Longitude:        -45811417.0 / 600000,
+		Latitude:         22130260.0 / 600000,
+		EPFD:             7,
+		RadioStatus:      67039,
+	}, r)
+
+	_, err = vdm.DecodeUTCDateResponse()
+	assert.EqualError(t, err, "nmea: AIVDM invalid utc/date response: message type 4")
+}
+
+func TestDecodeUTCDateResponse(t *testing.T) {
+	// same payload as the type 4 report, with the message type changed to 11.
+	vdm := mustParseVDMVDO(t, "!AIVDM,1,1,,A,;03OviQuMGCqWrRO9>E6fE700@GO,0*42")
+	r, err := vdm.DecodeUTCDateResponse()
+	assert.NoError(t, err)
+	assert.Equal(t, AISBaseStationReport{
+		MessageType:      11,
+		MMSI:             3669702,
+		Year:             2007,
+		Month:            5,
+		Day:              14,
+		Hour:             19,
+		Minute:           57,
+		Second:           39,
+		PositionAccuracy: true,
+		Longitude:        -45811417.0 / 600000,
+		Latitude:         22130260.0 / 600000,
+		EPFD:             7,
+		RadioStatus:      67039,
+	}, r)
+
+	_, err = vdm.DecodeBaseStationReport()
+	assert.EqualError(t, err, "nmea: AIVDM invalid base station report: message type 11")
+
+	vdm = mustParseVDMVDO(t, "!AIVDM,1,1,,A,H77nSfPh4U=<E`H4U8G;:222220,2*1F")
+	_, err = vdm.DecodeUTCDateResponse()
+	assert.EqualError(t, err, "nmea: AIVDM invalid utc/date response: payload too short")
+}
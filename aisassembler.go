@@ -0,0 +1,139 @@
+package nmea
+
+import (
+	"fmt"
+	"time"
+)
+
+// maxAISFragments is the largest number of fragments an AIS message can span.
+const maxAISFragments = 9
+
+// AISAssembler reassembles VDM/VDO messages spanning multiple fragments.
+// The zero value is ready to use.
+type AISAssembler struct {
+	// MaxPending limits the number of incomplete messages buffered at once.
+	// When the limit is reached the oldest incomplete message is evicted
+	// to make room for a new one. Zero means no limit.
+	MaxPending int
+	// Timeout is the time after which an incomplete message is dropped,
+	// counted from the arrival of its first fragment. Zero means no timeout.
+	Timeout time.Duration
+
+	pending map[aisKey]*aisMessage
+	order   []aisKey // pending keys, oldest first
+	evicted int
+	expired int
+	now     func() time.Time // time.Now if nil
+}
+
+// aisKey identifies the fragments belonging to the same message.
+type aisKey struct {
+	prefix    string
+	channel   string
+	messageID int64
+}
+
+// aisMessage holds the fragments of an incomplete message.
+type aisMessage struct {
+	fragments []*VDMVDO
+	received  int
+	started   time.Time
+}
+
+// Add buffers the fragment and returns the reassembled message once all
+// of its fragments have been received, in any order. Single fragment
+// messages are returned immediately. A fragment count above 9, a fragment
+// number outside the count, or a fragment number received twice for the
+// same message is an error.
+func (a *AISAssembler) Add(s VDMVDO) (complete *VDMVDO, done bool, err error) {
+	a.expire()
+	if s.NumFragments < 1 || s.NumFragments > maxAISFragments {
+		return nil, false, fmt.Errorf("nmea: %s invalid number of fragments: %d", s.Prefix(), s.NumFragments)
+	}
+	if s.FragmentNumber < 1 || s.FragmentNumber > s.NumFragments {
+		return nil, false, fmt.Errorf("nmea: %s invalid fragment number: %d", s.Prefix(), s.FragmentNumber)
+	}
+	if s.NumFragments == 1 {
+		return &s, true, nil
+	}
+	if a.pending == nil {
+		a.pending = map[aisKey]*aisMessage{}
+	}
+	key := aisKey{s.Prefix(), s.Channel, s.MessageID}
+	msg, ok := a.pending[key]
+	if !ok || int64(len(msg.fragments)) != s.NumFragments {
+		if ok {
+			a.remove(key)
+		}
+		if a.MaxPending > 0 && len(a.order) >= a.MaxPending {
+			a.remove(a.order[0])
+			a.evicted++
+		}
+		msg = &aisMessage{fragments: make([]*VDMVDO, s.NumFragments), started: a.clock()}
+		a.pending[key] = msg
+		a.order = append(a.order, key)
+	}
+	if msg.fragments[s.FragmentNumber-1] != nil {
+		return nil, false, fmt.Errorf("nmea: %s duplicate fragment number: %d", s.Prefix(), s.FragmentNumber)
+	}
+	msg.fragments[s.FragmentNumber-1] = &s
+	msg.received++
+	if msg.received < len(msg.fragments) {
+		return nil, false, nil
+	}
+	a.remove(key)
+
+	result := *msg.fragments[0]
+	result.Payload = []byte{}
+	for _, f := range msg.fragments {
+		result.Payload = append(result.Payload, f.Payload...)
+	}
+	return &result, true, nil
+}
+
+// PendingCount returns the number of incomplete messages currently buffered.
+func (a *AISAssembler) PendingCount() int {
+	return len(a.order)
+}
+
+// EvictedCount returns the number of incomplete messages dropped
+// because the MaxPending limit was reached.
+func (a *AISAssembler) EvictedCount() int {
+	return a.evicted
+}
+
+// ExpiredCount returns the number of incomplete messages dropped
+// because they were not completed within the Timeout.
+func (a *AISAssembler) ExpiredCount() int {
+	return a.expired
+}
+
+// expire drops the incomplete messages older than the Timeout.
+func (a *AISAssembler) expire() {
+	if a.Timeout <= 0 {
+		return
+	}
+	now := a.clock()
+	for len(a.order) > 0 && now.Sub(a.pending[a.order[0]].started) > a.Timeout {
+		a.remove(a.order[0])
+		a.expired++
+	}
+}
+
+func (a *AISAssembler) clock() time.Time {
+	if a.now != nil {
+		return a.now()
+	}
+	return time.Now()
+}
+
+// remove drops the pending message with the given key.
+func (a *AISAssembler) remove(key aisKey) {
+	delete(a.pending, key)
+	for i, k := range a.order {
+		if k == key {
+			a.order = append(a.order[:i], a.order[i+1:]...)
+			break
+		}
+	}
+}
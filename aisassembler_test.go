@@ -0,0 +1,150 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParseVDMVDO(t *testing.T, raw string) VDMVDO {
+	t.Helper()
+	m, err := Parse(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m.(VDMVDO)
+}
+
+func TestAISAssembler(t *testing.T) {
+	first := mustParseVDMVDO(t, "!AIVDM,2,1,3,B,55P5TL01VIaAL@7WKO@mBplU@<PDhh000000001S;AJ::4A80?4i@E53,0*3E")
+	second := mustParseVDMVDO(t, "!AIVDM,2,2,3,B,1@0000000000000,2*55")
+
+	var a AISAssembler
+	msg, done, err := a.Add(first)
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.Nil(t, msg)
+	assert.Equal(t, 1, a.PendingCount())
+
+	msg, done, err = a.Add(second)
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, append(append([]byte{}, first.Payload...), second.Payload...), msg.Payload)
+	assert.Equal(t, 0, a.PendingCount())
+}
+
+func TestAISAssemblerSingleFragment(t *testing.T) {
+	single := mustParseVDMVDO(t, "!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55")
+
+	var a AISAssembler
+	msg, done, err := a.Add(single)
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, single, *msg)
+	assert.Equal(t, 0, a.PendingCount())
+}
+
+func TestAISAssemblerInvalidFragmentNumber(t *testing.T) {
+	s := mustParseVDMVDO(t, "!AIVDM,2,2,3,B,1@0000000000000,2*55")
+	s.FragmentNumber = 3
+
+	var a AISAssembler
+	_, done, err := a.Add(s)
+	assert.False(t, done)
+	assert.EqualError(t, err, "nmea: AIVDM invalid fragment number: 3")
+}
+
+func TestAISAssemblerInvalidFragmentCount(t *testing.T) {
+	var a AISAssembler
+	_, done, err := a.Add(mustParseVDMVDO(t, "!AIVDM,99999999999,1,3,A,13aGmP0P00PD;88MD5MTDww@2<0L,0*18"))
+	assert.False(t, done)
+	assert.EqualError(t, err, "nmea: AIVDM invalid number of fragments: 99999999999")
+	assert.Equal(t, 0, a.PendingCount())
+
+	s := mustParseVDMVDO(t, "!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55")
+	s.NumFragments = 0
+	_, done, err = a.Add(s)
+	assert.False(t, done)
+	assert.EqualError(t, err, "nmea: AIVDM invalid number of fragments: 0")
+
+	s.NumFragments, s.FragmentNumber = 1, 2
+	_, done, err = a.Add(s)
+	assert.False(t, done)
+	assert.EqualError(t, err, "nmea: AIVDM invalid fragment number: 2")
+}
+
+func TestAISAssemblerMaxPending(t *testing.T) {
+	raws := []string{
+		"!AIVDM,2,1,1,A,55P5TL01VIaAL@7WKO@mBplU@<PDhh000000001S;AJ::4A80?4i@E53,0*3F",
+		"!AIVDM,2,1,2,A,55P5TL01VIaAL@7WKO@mBplU@<PDhh000000001S;AJ::4A80?4i@E53,0*3C",
+		"!AIVDM,2,1,3,B,55P5TL01VIaAL@7WKO@mBplU@<PDhh000000001S;AJ::4A80?4i@E53,0*3E",
+	}
+	a := AISAssembler{MaxPending: 2}
+	for _, raw := range raws {
+		_, done, err := a.Add(mustParseVDMVDO(t, raw))
+		assert.NoError(t, err)
+		assert.False(t, done)
+	}
+	assert.Equal(t, 2, a.PendingCount())
+	assert.Equal(t, 1, a.EvictedCount())
+
+	// the oldest message (id 1) was evicted, so its second fragment
+	// starts a new incomplete message instead of completing it.
+	last := mustParseVDMVDO(t, "!AIVDM,2,2,3,B,1@0000000000000,2*55")
+	last.Channel, last.MessageID = "A", 1
+	_, done, err := a.Add(last)
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.Equal(t, 2, a.PendingCount())
+	assert.Equal(t, 2, a.EvictedCount())
+
+	// the newest message (id 3) is still buffered.
+	_, done, err = a.Add(mustParseVDMVDO(t, "!AIVDM,2,2,3,B,1@0000000000000,2*55"))
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, 1, a.PendingCount())
+}
+
+func TestAISAssemblerOutOfOrder(t *testing.T) {
+	first := mustParseVDMVDO(t, "!AIVDM,2,1,3,B,55P5TL01VIaAL@7WKO@mBplU@<PDhh000000001S;AJ::4A80?4i@E53,0*3E")
+	second := mustParseVDMVDO(t, "!AIVDM,2,2,3,B,1@0000000000000,2*55")
+
+	var a AISAssembler
+	_, done, err := a.Add(second)
+	assert.NoError(t, err)
+	assert.False(t, done)
+
+	msg, done, err := a.Add(first)
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, append(append([]byte{}, first.Payload...), second.Payload...), msg.Payload)
+	assert.Equal(t, int64(1), msg.FragmentNumber)
+}
+
+func TestAISAssemblerDuplicateFragment(t *testing.T) {
+	first := mustParseVDMVDO(t, "!AIVDM,2,1,3,B,55P5TL01VIaAL@7WKO@mBplU@<PDhh000000001S;AJ::4A80?4i@E53,0*3E")
+
+	var a AISAssembler
+	_, _, err := a.Add(first)
+	assert.NoError(t, err)
+	_, done, err := a.Add(first)
+	assert.False(t, done)
+	assert.EqualError(t, err, "nmea: AIVDM duplicate fragment number: 1")
+	assert.Equal(t, 1, a.PendingCount())
+}
+
+func TestAISAssemblerTimeout(t *testing.T) {
+	now := time.Unix(1000, 0)
+	a := AISAssembler{Timeout: time.Second, now: func() time.Time { return now }}
+
+	_, _, err := a.Add(mustParseVDMVDO(t, "!AIVDM,2,1,3,B,55P5TL01VIaAL@7WKO@mBplU@<PDhh000000001S;AJ::4A80?4i@E53,0*3E"))
+	assert.NoError(t, err)
+
+	now = now.Add(2 * time.Second)
+	_, done, err := a.Add(mustParseVDMVDO(t, "!AIVDM,2,2,3,B,1@0000000000000,2*55"))
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.Equal(t, 1, a.PendingCount())
+	assert.Equal(t, 1, a.ExpiredCount())
+}
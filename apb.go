@@ -0,0 +1,99 @@
+package nmea
+
+const (
+	// TypeAPB type for APB sentences
+	TypeAPB = "APB"
+	// ValidAPB data valid
+	ValidAPB = "A"
+	// InvalidAPB data invalid, e.g. Loran-C blink or SNR warning
+	InvalidAPB = "V"
+	// LeftAPB steer left to correct the cross track error
+	LeftAPB = "L"
+	// RightAPB steer right to correct the cross track error
+	RightAPB = "R"
+	// NauticalMilesAPB cross track error in nautical miles
+	NauticalMilesAPB = "N"
+	// KilometersAPB cross track error in kilometers
+	KilometersAPB = "K"
+	// MagneticAPB magnetic bearing or heading
+	MagneticAPB = "M"
+	// TrueAPB true bearing or heading
+	TrueAPB = "T"
+)
+
+// APB is the autopilot sentence "B", the heading and cross track error
+// an autopilot needs to steer towards the destination waypoint.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_apb_autopilot_sentence_b
+type APB struct {
+	BaseSentence
+	StatusGeneral                   string  // Status, A = valid, V = Loran-C blink or SNR warning
+	StatusCycleLock                 string  // Status, A = valid, V = Loran-C cycle lock warning
+	CrossTrackError                 float64 // Magnitude of the cross track error
+	SteerDirection                  string  // Direction to steer, L = left, R = right
+	XTEUnits                        string  // Cross track error units, N = nautical miles, K = kilometers
+	ArrivalCircleEntered            string  // A = arrival circle entered, V = not entered
+	PerpendicularPassed             string  // A = perpendicular passed at the destination waypoint, V = not passed
+	BearingOriginToDestination      float64 // Bearing from origin to destination in degrees
+	BearingOriginToDestinationType  string  // M = magnetic, T = true
+	DestinationWaypointID           string  // Destination waypoint ID
+	BearingPresentToDestination     float64 // Bearing from present position to destination in degrees
+	BearingPresentToDestinationType string  // M = magnetic, T = true
+	HeadingToSteer                  float64 // Heading to steer to the destination waypoint in degrees
+	HeadingToSteerType              string  // M = magnetic, T = true
+	FAAMode                         string  // FAA mode indicator (NMEA 2.3 and later), empty if not sent
+}
+
+func (s APB) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"status_general":                      s.StatusGeneral,
+		"status_cycle_lock":                   s.StatusCycleLock,
+		"cross_track_error":                   s.CrossTrackError,
+		"steer_direction":                     s.SteerDirection,
+		"xte_units":                           s.XTEUnits,
+		"arrival_circle_entered":              s.ArrivalCircleEntered,
+		"perpendicular_passed":                s.PerpendicularPassed,
+		"bearing_origin_to_destination":       s.BearingOriginToDestination,
+		"bearing_origin_to_destination_type":  s.BearingOriginToDestinationType,
+		"destination_waypoint_id":             s.DestinationWaypointID,
+		"bearing_present_to_destination":      s.BearingPresentToDestination,
+		"bearing_present_to_destination_type": s.BearingPresentToDestinationType,
+		"heading_to_steer":                    s.HeadingToSteer,
+		"heading_to_steer_type":               s.HeadingToSteerType,
+		"faa_mode":                            s.FAAMode,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newAPB constructor
+func newAPB(s BaseSentence) (APB, error) {
+	p := NewParser(s)
+	p.AssertType(TypeAPB)
+	m := APB{
+		BaseSentence:                    s,
+		StatusGeneral:                   p.EnumString(0, "general status", ValidAPB, InvalidAPB),
+		StatusCycleLock:                 p.EnumString(1, "cycle lock status", ValidAPB, InvalidAPB),
+		CrossTrackError:                 p.Float64(2, "cross track error"),
+		SteerDirection:                  p.EnumString(3, "steer direction", LeftAPB, RightAPB),
+		XTEUnits:                        p.EnumString(4, "cross track error units", NauticalMilesAPB, KilometersAPB),
+		ArrivalCircleEntered:            p.EnumString(5, "arrival circle entered", ValidAPB, InvalidAPB),
+		PerpendicularPassed:             p.EnumString(6, "perpendicular passed", ValidAPB, InvalidAPB),
+		BearingOriginToDestination:      p.Float64(7, "bearing origin to destination"),
+		BearingOriginToDestinationType:  p.EnumString(8, "bearing origin to destination type", MagneticAPB, TrueAPB),
+		DestinationWaypointID:           p.String(9, "destination waypoint ID"),
+		BearingPresentToDestination:     p.Float64(10, "bearing present to destination"),
+		BearingPresentToDestinationType: p.EnumString(11, "bearing present to destination type", MagneticAPB, TrueAPB),
+		HeadingToSteer:                  p.Float64(12, "heading to steer"),
+		HeadingToSteerType:              p.EnumString(13, "heading to steer type", MagneticAPB, TrueAPB),
+	}
+	if len(m.Fields) > 14 {
+		m.FAAMode = p.EnumString(14, "FAA mode", AutonomousGNS, DifferentialGNS, EstimatedGNS, ManualGNS, SimulatorGNS, NoFixGNS, PreciseGNS, RealTimeKinematicGNS, FloatRTKGNS)
+	}
+	return m, p.Err()
+}
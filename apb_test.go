@@ -0,0 +1,91 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var apbtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  APB
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPAPB,A,A,0.10,R,N,V,V,011,M,DEST,011,M,011,M*3C",
+		msg: APB{
+			StatusGeneral:                   ValidAPB,
+			StatusCycleLock:                 ValidAPB,
+			CrossTrackError:                 0.1,
+			SteerDirection:                  RightAPB,
+			XTEUnits:                        NauticalMilesAPB,
+			ArrivalCircleEntered:            InvalidAPB,
+			PerpendicularPassed:             InvalidAPB,
+			BearingOriginToDestination:      11,
+			BearingOriginToDestinationType:  MagneticAPB,
+			DestinationWaypointID:           "DEST",
+			BearingPresentToDestination:     11,
+			BearingPresentToDestinationType: MagneticAPB,
+			HeadingToSteer:                  11,
+			HeadingToSteerType:              MagneticAPB,
+		},
+	},
+	{
+		name: "good sentence with FAA mode",
+		raw:  "$GPAPB,A,A,0.10,R,N,V,V,011,M,DEST,011,M,011,M,A*51",
+		msg: APB{
+			StatusGeneral:                   ValidAPB,
+			StatusCycleLock:                 ValidAPB,
+			CrossTrackError:                 0.1,
+			SteerDirection:                  RightAPB,
+			XTEUnits:                        NauticalMilesAPB,
+			ArrivalCircleEntered:            InvalidAPB,
+			PerpendicularPassed:             InvalidAPB,
+			BearingOriginToDestination:      11,
+			BearingOriginToDestinationType:  MagneticAPB,
+			DestinationWaypointID:           "DEST",
+			BearingPresentToDestination:     11,
+			BearingPresentToDestinationType: MagneticAPB,
+			HeadingToSteer:                  11,
+			HeadingToSteerType:              MagneticAPB,
+			FAAMode:                         AutonomousGNS,
+		},
+	},
+	{
+		name: "empty fields",
+		raw:  "$GPAPB,V,V,,,,,,,,,,,,*44",
+		msg: APB{
+			StatusGeneral:   InvalidAPB,
+			StatusCycleLock: InvalidAPB,
+		},
+	},
+	{
+		name: "bad cross track error units",
+		raw:  "$GPAPB,A,A,0.10,R,X,V,V,011,M,DEST,011,M,011,M*2A",
+		err:  "nmea: GPAPB invalid cross track error units: X",
+	},
+	{
+		name: "missing heading to steer type",
+		raw:  "$GPAPB,A,A,0.10,R,N,V,V,011,M,DEST,011,M,011*5D",
+		err:  "nmea: GPAPB has 13 fields, want at least 14",
+	},
+}
+
+func TestAPB(t *testing.T) {
+	for _, tt := range apbtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				apb := m.(APB)
+				apb.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, apb)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package nmea
+
+const (
+	// TypeBOD type for BOD sentences
+	TypeBOD = "BOD"
+	// TrueBOD true bearing
+	TrueBOD = "T"
+	// MagneticBOD magnetic bearing
+	MagneticBOD = "M"
+)
+
+// BOD is the bearing from the origin waypoint to the destination waypoint.
+// The origin waypoint ID is empty or absent when no route is active.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_bod_bearing_waypoint_to_waypoint
+type BOD struct {
+	BaseSentence
+	BearingTrue         float64 // True bearing in degrees
+	BearingTrueType     string  // T = true
+	BearingMagnetic     float64 // Magnetic bearing in degrees
+	BearingMagneticType string  // M = magnetic
+	DestinationID       string  // Destination waypoint ID
+	OriginID            string  // Origin waypoint ID
+}
+
+func (s BOD) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"bearing_true":          s.BearingTrue,
+		"bearing_true_type":     s.BearingTrueType,
+		"bearing_magnetic":      s.BearingMagnetic,
+		"bearing_magnetic_type": s.BearingMagneticType,
+		"destination_id":        s.DestinationID,
+		"origin_id":             s.OriginID,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newBOD constructor
+func newBOD(s BaseSentence) (BOD, error) {
+	p := NewParser(s)
+	p.AssertType(TypeBOD)
+	m := BOD{
+		BaseSentence:        s,
+		BearingTrue:         p.Float64(0, "true bearing"),
+		BearingTrueType:     p.EnumString(1, "true bearing type", TrueBOD),
+		BearingMagnetic:     p.Float64(2, "magnetic bearing"),
+		BearingMagneticType: p.EnumString(3, "magnetic bearing type", MagneticBOD),
+		DestinationID:       p.String(4, "destination waypoint ID"),
+	}
+	if len(m.Fields) > 5 {
+		m.OriginID = p.String(5, "origin waypoint ID")
+	}
+	return m, p.Err()
+}
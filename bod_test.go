@@ -0,0 +1,71 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var bodtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  BOD
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPBOD,099.3,T,105.6,M,POINTB,POINTA*45",
+		msg: BOD{
+			BearingTrue:         99.3,
+			BearingTrueType:     TrueBOD,
+			BearingMagnetic:     105.6,
+			BearingMagneticType: MagneticBOD,
+			DestinationID:       "POINTB",
+			OriginID:            "POINTA",
+		},
+	},
+	{
+		name: "empty origin",
+		raw:  "$GPBOD,099.3,T,105.6,M,POINTB,*48",
+		msg: BOD{
+			BearingTrue:         99.3,
+			BearingTrueType:     TrueBOD,
+			BearingMagnetic:     105.6,
+			BearingMagneticType: MagneticBOD,
+			DestinationID:       "POINTB",
+		},
+	},
+	{
+		name: "missing origin",
+		raw:  "$GPBOD,099.3,T,105.6,M,POINTB*64",
+		msg: BOD{
+			BearingTrue:         99.3,
+			BearingTrueType:     TrueBOD,
+			BearingMagnetic:     105.6,
+			BearingMagneticType: MagneticBOD,
+			DestinationID:       "POINTB",
+		},
+	},
+	{
+		name: "bad true bearing type",
+		raw:  "$GPBOD,099.3,X,105.6,M,POINTB,POINTA*49",
+		err:  "nmea: GPBOD invalid true bearing type: X",
+	},
+}
+
+func TestBOD(t *testing.T) {
+	for _, tt := range bodtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				bod := m.(BOD)
+				bod.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, bod)
+			}
+		})
+	}
+}
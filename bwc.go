@@ -0,0 +1,81 @@
+package nmea
+
+const (
+	// TypeBWC type for BWC sentences
+	TypeBWC = "BWC"
+	// TrueBWC true bearing
+	TrueBWC = "T"
+	// MagneticBWC magnetic bearing
+	MagneticBWC = "M"
+	// NauticalMilesBWC distance unit
+	NauticalMilesBWC = "N"
+)
+
+// BWC is the great circle bearing and distance to a waypoint.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_bwc_bearing_distance_to_waypoint_great_circle
+type BWC struct {
+	BaseSentence
+	Time                Time    // UTC time of the observation
+	Latitude            float64 // Waypoint latitude
+	Longitude           float64 // Waypoint longitude
+	BearingTrue         float64 // True bearing to the waypoint in degrees
+	BearingTrueType     string  // T = true
+	BearingMagnetic     float64 // Magnetic bearing to the waypoint in degrees
+	BearingMagneticType string  // M = magnetic
+	Distance            float64 // Distance to the waypoint in nautical miles
+	DistanceUnit        string  // N = nautical miles
+	WaypointID          string  // Waypoint ID
+	FAAMode             string  // FAA mode indicator (NMEA 2.3 and later), empty if not sent
+}
+
+func (s BWC) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"time":                  s.Time.String(),
+		"latitude":              roundCoordinate(s.Latitude),
+		"longitude":             roundCoordinate(s.Longitude),
+		"bearing_true":          s.BearingTrue,
+		"bearing_true_type":     s.BearingTrueType,
+		"bearing_magnetic":      s.BearingMagnetic,
+		"bearing_magnetic_type": s.BearingMagneticType,
+		"distance":              s.Distance,
+		"distance_unit":         s.DistanceUnit,
+		"waypoint_id":           s.WaypointID,
+		"faa_mode":              s.FAAMode,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newBWC constructor
+func newBWC(s BaseSentence) (BWC, error) {
+	p := NewParser(s)
+	p.AssertType(TypeBWC)
+	m := BWC{
+		BaseSentence:        s,
+		Time:                p.Time(0, "time"),
+		BearingTrue:         p.Float64(5, "true bearing"),
+		BearingTrueType:     p.EnumString(6, "true bearing type", TrueBWC),
+		BearingMagnetic:     p.Float64(7, "magnetic bearing"),
+		BearingMagneticType: p.EnumString(8, "magnetic bearing type", MagneticBWC),
+		Distance:            p.Float64(9, "distance"),
+		DistanceUnit:        p.EnumString(10, "distance unit", NauticalMilesBWC),
+		WaypointID:          p.String(11, "waypoint ID"),
+	}
+	// The waypoint position is empty when no destination is set.
+	if m.FieldPresent(1) || m.FieldPresent(2) {
+		m.Latitude = float64(p.Latitude(1, 2, "latitude"))
+	}
+	if m.FieldPresent(3) || m.FieldPresent(4) {
+		m.Longitude = float64(p.Longitude(3, 4, "longitude"))
+	}
+	if len(m.Fields) > 12 {
+		m.FAAMode = p.EnumString(12, "FAA mode", AutonomousGNS, DifferentialGNS, EstimatedGNS, ManualGNS, SimulatorGNS, NoFixGNS, PreciseGNS, RealTimeKinematicGNS, FloatRTKGNS)
+	}
+	return m, p.Err()
+}
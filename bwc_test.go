@@ -0,0 +1,75 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var bwctests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  BWC
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPBWC,081837,4917.24,N,12309.57,W,051.9,T,031.6,M,001.3,N,004*2D",
+		msg: BWC{
+			Time:                Time{true, 8, 18, 37, 0},
+			Latitude:            MustParseGPS("4917.24 N"),
+			Longitude:           MustParseGPS("12309.57 W"),
+			BearingTrue:         51.9,
+			BearingTrueType:     TrueBWC,
+			BearingMagnetic:     31.6,
+			BearingMagneticType: MagneticBWC,
+			Distance:            1.3,
+			DistanceUnit:        NauticalMilesBWC,
+			WaypointID:          "004",
+		},
+	},
+	{
+		name: "good sentence with FAA mode",
+		raw:  "$GPBWC,081837,4917.24,N,12309.57,W,051.9,T,031.6,M,001.3,N,004,A*40",
+		msg: BWC{
+			Time:                Time{true, 8, 18, 37, 0},
+			Latitude:            MustParseGPS("4917.24 N"),
+			Longitude:           MustParseGPS("12309.57 W"),
+			BearingTrue:         51.9,
+			BearingTrueType:     TrueBWC,
+			BearingMagnetic:     31.6,
+			BearingMagneticType: MagneticBWC,
+			Distance:            1.3,
+			DistanceUnit:        NauticalMilesBWC,
+			WaypointID:          "004",
+			FAAMode:             AutonomousGNS,
+		},
+	},
+	{
+		name: "bad latitude hemisphere",
+		raw:  "$GPBWC,081837,4917.24,X,12309.57,W,051.9,T,031.6,M,001.3,N,004*3B",
+		err:  "nmea: GPBWC invalid latitude: invalid hemisphere [X]",
+	},
+	{
+		name: "bad FAA mode",
+		raw:  "$GPBWC,081837,4917.24,N,12309.57,W,051.9,T,031.6,M,001.3,N,004,X*59",
+		err:  "nmea: GPBWC invalid FAA mode: X",
+	},
+}
+
+func TestBWC(t *testing.T) {
+	for _, tt := range bwctests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				bwc := m.(BWC)
+				bwc.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, bwc)
+			}
+		})
+	}
+}
@@ -36,6 +36,23 @@ func (s DBT) ToMap() (map[string]interface{}, error) {
 	return m, nil
 }
 
+// IsLikelyDropout reports whether every depth field is empty or zero,
+// which usually indicates a sensor dropout rather than a grounding.
+func (s DBT) IsLikelyDropout() bool {
+	return s.DepthFeet == 0 && s.DepthMeters == 0 && s.DepthFathom == 0
+}
+
+// Encode formats the sentence into a checksummed raw sentence.
+// Depths keep the decimals they were parsed with.
+func (s DBT) Encode() (string, error) {
+	return Encode([]string{
+		s.Prefix(),
+		formatFloatAs(s.DepthFeet, s.field(0)), s.Feet,
+		formatFloatAs(s.DepthMeters, s.field(2)), s.Meters,
+		formatFloatAs(s.DepthFathom, s.field(4)), s.Fathom,
+	})
+}
+
 // newDBT constructor
 func newDBT(s BaseSentence) (DBT, error) {
 	p := NewParser(s)
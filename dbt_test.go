@@ -43,3 +43,52 @@ func Test_newDBT(t *testing.T) {
 		})
 	}
 }
+
+func TestDBT_IsLikelyDropout(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want bool
+	}{
+		{name: "shallow reading", raw: makeSentence("$SDDBT,1.6,f,0.5,M,0.3,F"), want: false},
+		{name: "zero depth", raw: makeSentence("$SDDBT,0.0,f,0.0,M,0.0,F"), want: true},
+		{name: "empty depth", raw: makeSentence("$SDDBT,,f,,M,,F"), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if err != nil {
+				t.Errorf("newDBT() error = %v", err)
+				return
+			}
+			if got := m.(DBT).IsLikelyDropout(); got != tt.want {
+				t.Errorf("DBT.IsLikelyDropout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDBT_Encode(t *testing.T) {
+	for _, raw := range []string{
+		makeSentence("$BDDBT,10,f,100,M,1000,F"),
+		makeSentence("$SDDBT,1.6,f,0.5,M,0.3,F"),
+		makeSentence("$SDDBT,0.0,f,0.00,M,0.0,F"),
+		makeSentence("$SDDBT,,f,,M,,F"),
+	} {
+		t.Run(raw, func(t *testing.T) {
+			m, err := Parse(raw)
+			if err != nil {
+				t.Errorf("newDBT() error = %v", err)
+				return
+			}
+			got, err := m.(DBT).Encode()
+			if err != nil {
+				t.Errorf("DBT.Encode() error = %v", err)
+				return
+			}
+			if got != raw {
+				t.Errorf("DBT.Encode() = %v, want %v", got, raw)
+			}
+		})
+	}
+}
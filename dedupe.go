@@ -0,0 +1,94 @@
+package nmea
+
+import "strings"
+
+// SentenceSource is a stream of parsed sentences such as a Scanner.
+type SentenceSource interface {
+	Scan() bool
+	Sentence() (Sentence, error)
+}
+
+// timeFields maps the data type of sentences carrying the time of a fix
+// or observation to the index of their time field. ZDA is left out since
+// its time is the payload itself.
+var timeFields = map[string]int{
+	TypeGGA: 0,
+	TypeGLL: 4,
+	TypeGNS: 0,
+	TypeRMC: 0,
+	TypeGST: 0,
+	TypeGBS: 0,
+	TypeGRS: 0,
+	TypeBWC: 0,
+}
+
+// Deduper wraps a SentenceSource and suppresses consecutive sentences
+// that are identical, as some devices repeat the same sentence several
+// times per second. Sentences that failed to parse are never suppressed.
+type Deduper struct {
+	// KeyFunc returns the key two sentences are compared by.
+	// If nil, sentences are compared by their prefix and fields.
+	KeyFunc func(Sentence) string
+	// IgnoreTime makes the default comparison skip the time field of
+	// GGA, GLL, GNS, RMC, GST, GBS, GRS and BWC sentences, so repeats
+	// stamped with a new time are suppressed too. Note that it also
+	// collapses the successive fixes of a stationary receiver.
+	IgnoreTime bool
+
+	src      SentenceSource
+	last     string
+	hasLast  bool
+	sentence Sentence
+	err      error
+}
+
+// NewDeduper returns a new Deduper reading from src.
+func NewDeduper(src SentenceSource) *Deduper {
+	return &Deduper{src: src}
+}
+
+// Scan advances to the next sentence that differs from the previous one.
+// It returns false when the underlying source is exhausted.
+func (d *Deduper) Scan() bool {
+	for d.src.Scan() {
+		d.sentence, d.err = d.src.Sentence()
+		if d.err != nil {
+			return true
+		}
+		key := d.key(d.sentence)
+		if d.hasLast && key == d.last {
+			continue
+		}
+		d.last, d.hasLast = key, true
+		return true
+	}
+	d.sentence, d.err = nil, nil
+	return false
+}
+
+// Sentence returns the sentence read by the most recent call to Scan
+// along with its parse error, if any.
+func (d *Deduper) Sentence() (Sentence, error) {
+	return d.sentence, d.err
+}
+
+func (d *Deduper) key(s Sentence) string {
+	if d.KeyFunc != nil {
+		return d.KeyFunc(s)
+	}
+	return payloadKey(s, d.IgnoreTime)
+}
+
+// payloadKey returns the prefix and fields of the sentence without its
+// checksum, and without its time field if ignoreTime is set.
+func payloadKey(s Sentence, ignoreTime bool) string {
+	raw := s.String()
+	if i := strings.Index(raw, ChecksumSep); i != -1 {
+		raw = raw[:i]
+	}
+	fields := strings.Split(raw, FieldSep)
+	if i, ok := timeFields[s.DataType()]; ignoreTime && ok && i+1 < len(fields) {
+		fields[i+1] = ""
+	}
+	return strings.Join(fields, FieldSep)
+}
@@ -0,0 +1,91 @@
+package nmea
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeduper(t *testing.T) {
+	input := strings.Join([]string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GPGGA,034226.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*52",
+		"$GPGGA,034226.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*00",
+		"$GPGGA,034227.077,3356.4651,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*52",
+		"$INTHS,123.456,A*20",
+		"$GPGGA,034227.077,3356.4651,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*52",
+	}, "\n")
+	d := NewDeduper(NewScanner(strings.NewReader(input)))
+
+	var raws []string
+	var errs int
+	for d.Scan() {
+		s, err := d.Sentence()
+		if err != nil {
+			errs++
+			continue
+		}
+		raws = append(raws, s.String())
+	}
+	assert.Equal(t, 1, errs)
+	assert.Equal(t, []string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GPGGA,034226.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*52",
+		"$GPGGA,034227.077,3356.4651,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*52",
+		"$INTHS,123.456,A*20",
+		"$GPGGA,034227.077,3356.4651,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*52",
+	}, raws)
+}
+
+func TestDeduperIgnoresTime(t *testing.T) {
+	input := strings.Join([]string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GPGGA,034226.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*52",
+		"$GPGST,034225.077,0.006,0.023,0.020,273.6,0.023,0.020,0.031*61",
+		"$GPGST,034226.077,0.006,0.023,0.020,273.6,0.023,0.020,0.031*62",
+		"$GPGST,034227.077,0.007,0.023,0.020,273.6,0.023,0.020,0.031*62",
+		"$GPZDA,034225.077,25,09,2005,00,00*5D",
+		"$GPZDA,034226.077,25,09,2005,00,00*5E",
+	}, "\n")
+	d := NewDeduper(NewScanner(strings.NewReader(input)))
+	d.IgnoreTime = true
+
+	var raws []string
+	for d.Scan() {
+		s, err := d.Sentence()
+		assert.NoError(t, err)
+		raws = append(raws, s.String())
+	}
+	assert.Equal(t, []string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GPGST,034225.077,0.006,0.023,0.020,273.6,0.023,0.020,0.031*61",
+		"$GPGST,034227.077,0.007,0.023,0.020,273.6,0.023,0.020,0.031*62",
+		"$GPZDA,034225.077,25,09,2005,00,00*5D",
+		"$GPZDA,034226.077,25,09,2005,00,00*5E",
+	}, raws)
+}
+
+func TestDeduperKeyFunc(t *testing.T) {
+	input := strings.Join([]string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GPGGA,034226.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*52",
+		"$INTHS,123.456,A*20",
+	}, "\n")
+	d := NewDeduper(NewScanner(strings.NewReader(input)))
+	d.KeyFunc = func(s Sentence) string { return s.String() }
+
+	var raws []string
+	for d.Scan() {
+		s, err := d.Sentence()
+		assert.NoError(t, err)
+		raws = append(raws, s.String())
+	}
+	assert.Equal(t, []string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GPGGA,034226.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*52",
+		"$INTHS,123.456,A*20",
+	}, raws)
+}
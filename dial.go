@@ -0,0 +1,139 @@
+package nmea
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultReconnectDelay is the delay before the first reconnection
+	// attempt when Scanner.ReconnectDelay is not set.
+	defaultReconnectDelay = time.Second
+	// maxReconnectDelay caps the delay between reconnection attempts.
+	maxReconnectDelay = time.Minute
+)
+
+// DialScanner connects to the address on the named network (e.g. "tcp",
+// see net.Dial) and returns a Scanner reading sentences from the connection,
+// such as those of a gpsd raw feed or an AIS aggregator. Set Reconnect on
+// the returned Scanner to redial when the connection is closed or fails.
+func DialScanner(network, addr string) (*Scanner, error) {
+	conn, err := net.Dial(network, addr)
+	if err != nil {
+		return nil, err
+	}
+	s := &Scanner{}
+	s.conn = &dialReader{network: network, addr: addr, scanner: s, conn: conn, done: make(chan struct{})}
+	s.scanner = bufio.NewScanner(s.conn)
+	return s, nil
+}
+
+// Close closes the connection of a Scanner returned by DialScanner and stops
+// it from reconnecting, after which Scan returns false. It does nothing for
+// other Scanners.
+func (s *Scanner) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
+
+// dialReader reads from a network connection, redialing it with an
+// exponential backoff when the Scanner it belongs to has Reconnect set.
+type dialReader struct {
+	network string
+	addr    string
+	scanner *Scanner
+
+	mu     sync.Mutex
+	conn   net.Conn
+	closed bool
+	done   chan struct{} // closed by Close
+}
+
+// Read reads from the current connection. When the connection ends and a
+// new one is established, a single newline is returned first so a line cut
+// off by the disconnection is not joined with the first line of the new one.
+func (r *dialReader) Read(p []byte) (int, error) {
+	for {
+		r.mu.Lock()
+		conn, closed := r.conn, r.closed
+		r.mu.Unlock()
+		if closed {
+			return 0, io.EOF
+		}
+		if conn == nil {
+			if err := r.redial(); err != nil {
+				return 0, err
+			}
+			p[0] = '\n'
+			return 1, nil
+		}
+		n, err := conn.Read(p)
+		if n > 0 || err == nil {
+			return n, nil
+		}
+		conn.Close()
+		r.mu.Lock()
+		r.conn = nil
+		closed = r.closed
+		r.mu.Unlock()
+		if closed {
+			return 0, io.EOF
+		}
+		if !r.scanner.Reconnect {
+			return 0, err
+		}
+	}
+}
+
+// redial connects again, doubling the delay before each attempt
+// until it succeeds, MaxReconnectAttempts attempts have failed,
+// or the reader is closed.
+func (r *dialReader) redial() error {
+	delay := r.scanner.ReconnectDelay
+	if delay <= 0 {
+		delay = defaultReconnectDelay
+	}
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-r.done:
+			return io.EOF
+		case <-time.After(delay):
+		}
+		conn, err := net.Dial(r.network, r.addr)
+		if err == nil {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			if r.closed {
+				conn.Close()
+				return io.EOF
+			}
+			r.conn = conn
+			return nil
+		}
+		if limit := r.scanner.MaxReconnectAttempts; limit > 0 && attempt >= limit {
+			return err
+		}
+		if delay *= 2; delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+// Close closes the current connection and prevents reconnection.
+func (r *dialReader) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.closed {
+		close(r.done)
+	}
+	r.closed = true
+	if r.conn == nil {
+		return nil
+	}
+	return r.conn.Close()
+}
@@ -0,0 +1,129 @@
+package nmea
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// serveSentences accepts one connection per element of conns on l,
+// writes the given data to it and closes it.
+func serveSentences(l net.Listener, conns ...[]string) {
+	for _, lines := range conns {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		for _, data := range lines {
+			conn.Write([]byte(data))
+		}
+		conn.Close()
+	}
+	l.Close()
+}
+
+func TestDialScanner(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	go serveSentences(l, []string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51\r\n",
+		"$INTHS,123.456,A*20\r\n",
+	})
+
+	s, err := DialScanner("tcp", l.Addr().String())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer s.Close()
+
+	var types []string
+	for s.Scan() {
+		m, err := s.Sentence()
+		assert.NoError(t, err)
+		types = append(types, m.DataType())
+	}
+	assert.NoError(t, s.Err())
+	assert.Equal(t, []string{TypeGGA, TypeTHS}, types)
+}
+
+func TestDialScannerReconnect(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	go serveSentences(l, []string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51\r\n",
+		// cut off by the disconnection
+		"$INTHS,123.4",
+	}, []string{
+		"$INTHS,123.456,A*20\r\n",
+	})
+
+	s, err := DialScanner("tcp", l.Addr().String())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer s.Close()
+	s.Reconnect = true
+	s.ReconnectDelay = 10 * time.Millisecond
+	s.MaxReconnectAttempts = 2
+
+	var types []string
+	var errs int
+	for s.Scan() {
+		m, err := s.Sentence()
+		if err != nil {
+			errs++
+			continue
+		}
+		types = append(types, m.DataType())
+	}
+	assert.Error(t, s.Err())
+	assert.Equal(t, 1, errs)
+	assert.Equal(t, []string{TypeGGA, TypeTHS}, types)
+}
+
+func TestDialScannerError(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	addr := l.Addr().String()
+	l.Close()
+	_, err = DialScanner("tcp", addr)
+	assert.Error(t, err)
+}
+
+func TestDialScannerCloseWhileReconnecting(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	// the server goes away after the first connection
+	go serveSentences(l, []string{"$INTHS,123.456,A*20\r\n"})
+
+	s, err := DialScanner("tcp", l.Addr().String())
+	if !assert.NoError(t, err) {
+		return
+	}
+	s.Reconnect = true
+	s.ReconnectDelay = 10 * time.Millisecond
+
+	done := make(chan struct{})
+	go func() {
+		for s.Scan() {
+		}
+		close(done)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, s.Close())
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Scan did not return after Close")
+	}
+}
@@ -30,6 +30,45 @@ func (s DPT) ToMap() (map[string]interface{}, error) {
 	return m, nil
 }
 
+// IsLikelyDropout reports whether the depth is empty or zero,
+// which usually indicates a sensor dropout rather than a grounding.
+func (s DPT) IsLikelyDropout() bool {
+	return s.Depth == 0
+}
+
+// UnifiedDepth combines the DBT and DPT sentences of a depth sounder, either
+// of which may be nil, into the depth below the surface and below the keel in
+// meters. The depth below the transducer is taken from the DPT sentence, or
+// from the DBT sentence if the DPT one is missing or a dropout. The DPT offset
+// is then applied: a positive offset is the distance from the transducer to
+// the waterline and a negative one the distance to the keel. Without an offset
+// both depths are the depth below the transducer. ok is false if neither
+// sentence carries a depth.
+func UnifiedDepth(dbt *DBT, dpt *DPT) (belowSurface float64, belowKeel float64, ok bool) {
+	var depth float64
+	switch {
+	case dpt != nil && !dpt.IsLikelyDropout():
+		depth = dpt.Depth
+	case dbt != nil && dbt.DepthMeters != 0:
+		depth = dbt.DepthMeters
+	case dbt != nil && dbt.DepthFeet != 0:
+		depth = dbt.DepthFeet * 0.3048
+	case dbt != nil && dbt.DepthFathom != 0:
+		depth = dbt.DepthFathom * 1.8288
+	default:
+		return 0, 0, false
+	}
+	belowSurface, belowKeel = depth, depth
+	if dpt != nil {
+		if dpt.Offset > 0 {
+			belowSurface += dpt.Offset
+		} else {
+			belowKeel += dpt.Offset
+		}
+	}
+	return belowSurface, belowKeel, true
+}
+
 // newDPT constructor
 func newDPT(s BaseSentence) (DPT, error) {
 	p := NewParser(s)
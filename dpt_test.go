@@ -1,6 +1,7 @@
 package nmea
 
 import (
+	"math"
 	"testing"
 
 	"github.com/go-test/deep"
@@ -58,3 +59,58 @@ func Test_newDPT(t *testing.T) {
 		})
 	}
 }
+
+func TestDPT_IsLikelyDropout(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want bool
+	}{
+		{name: "shallow reading", raw: makeSentence("$SDDPT,0.5,0.0,"), want: false},
+		{name: "zero depth", raw: makeSentence("$SDDPT,0.0,0.0,"), want: true},
+		{name: "empty depth", raw: makeSentence("$SDDPT,,,"), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if err != nil {
+				t.Errorf("newDPT() error = %v", err)
+				return
+			}
+			if got := m.(DPT).IsLikelyDropout(); got != tt.want {
+				t.Errorf("DPT.IsLikelyDropout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnifiedDepth(t *testing.T) {
+	tests := []struct {
+		name         string
+		dbt          *DBT
+		dpt          *DPT
+		belowSurface float64
+		belowKeel    float64
+		ok           bool
+	}{
+		{name: "nothing", ok: false},
+		{name: "DBT only", dbt: &DBT{DepthFeet: 32.8, DepthMeters: 10, DepthFathom: 5.5}, belowSurface: 10, belowKeel: 10, ok: true},
+		{name: "DBT only in feet", dbt: &DBT{DepthFeet: 10}, belowSurface: 3.048, belowKeel: 3.048, ok: true},
+		{name: "DBT dropout", dbt: &DBT{}, ok: false},
+		{name: "DPT only with waterline offset", dpt: &DPT{Depth: 10, Offset: 0.5}, belowSurface: 10.5, belowKeel: 10, ok: true},
+		{name: "DPT only with keel offset", dpt: &DPT{Depth: 10, Offset: -1.5}, belowSurface: 10, belowKeel: 8.5, ok: true},
+		{name: "both", dbt: &DBT{DepthMeters: 9.8}, dpt: &DPT{Depth: 10, Offset: 0.5}, belowSurface: 10.5, belowKeel: 10, ok: true},
+		{name: "both with DPT dropout", dbt: &DBT{DepthMeters: 9.8}, dpt: &DPT{Offset: -1.5}, belowSurface: 9.8, belowKeel: 8.3, ok: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			belowSurface, belowKeel, ok := UnifiedDepth(tt.dbt, tt.dpt)
+			if ok != tt.ok {
+				t.Errorf("UnifiedDepth() ok = %v, want %v", ok, tt.ok)
+			}
+			if math.Abs(belowSurface-tt.belowSurface) > 1e-9 || math.Abs(belowKeel-tt.belowKeel) > 1e-9 {
+				t.Errorf("UnifiedDepth() = %v, %v, want %v, %v", belowSurface, belowKeel, tt.belowSurface, tt.belowKeel)
+			}
+		})
+	}
+}
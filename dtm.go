@@ -0,0 +1,63 @@
+package nmea
+
+const (
+	// TypeDTM type for DTM sentences
+	TypeDTM = "DTM"
+	// WGS84DTM WGS 84 datum code
+	WGS84DTM = "W84"
+	// UserDefinedDTM user defined datum code
+	UserDefinedDTM = "999"
+)
+
+// DTM is the datum reference, the local datum the positions of the other
+// sentences are expressed in and its offset from the reference datum.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_dtm_datum_reference
+type DTM struct {
+	BaseSentence
+	LocalDatumCode         string  // Local datum code, e.g. W84 or 999 = user defined
+	LocalDatumSubcode      string  // Local datum subdivision code, empty if not used
+	LatitudeOffsetMinutes  float64 // Latitude offset in minutes
+	LatitudeDirection      string  // Latitude offset direction, N or S
+	LongitudeOffsetMinutes float64 // Longitude offset in minutes
+	LongitudeDirection     string  // Longitude offset direction, E or W
+	AltitudeOffset         float64 // Altitude offset in meters
+	ReferenceDatumCode     string  // Reference datum code, e.g. W84
+}
+
+func (s DTM) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"local_datum_code":         s.LocalDatumCode,
+		"local_datum_subcode":      s.LocalDatumSubcode,
+		"latitude_offset_minutes":  s.LatitudeOffsetMinutes,
+		"latitude_direction":       s.LatitudeDirection,
+		"longitude_offset_minutes": s.LongitudeOffsetMinutes,
+		"longitude_direction":      s.LongitudeDirection,
+		"altitude_offset":          s.AltitudeOffset,
+		"reference_datum_code":     s.ReferenceDatumCode,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newDTM constructor
+func newDTM(s BaseSentence) (DTM, error) {
+	p := NewParser(s)
+	p.AssertType(TypeDTM)
+	return DTM{
+		BaseSentence:           s,
+		LocalDatumCode:         p.String(0, "local datum code"),
+		LocalDatumSubcode:      p.String(1, "local datum subcode"),
+		LatitudeOffsetMinutes:  p.Float64(2, "latitude offset minutes"),
+		LatitudeDirection:      p.EnumString(3, "latitude direction", North, South),
+		LongitudeOffsetMinutes: p.Float64(4, "longitude offset minutes"),
+		LongitudeDirection:     p.EnumString(5, "longitude direction", East, West),
+		AltitudeOffset:         p.Float64(6, "altitude offset"),
+		ReferenceDatumCode:     p.String(7, "reference datum code"),
+	}, p.Err()
+}
@@ -0,0 +1,61 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var dtmtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  DTM
+}{
+	{
+		name: "good sentence without subcode",
+		raw:  "$GPDTM,W84,,0.0,N,0.0,E,0.0,W84*6F",
+		msg: DTM{
+			LocalDatumCode:     WGS84DTM,
+			LatitudeDirection:  North,
+			LongitudeDirection: East,
+			ReferenceDatumCode: WGS84DTM,
+		},
+	},
+	{
+		name: "good sentence with subcode",
+		raw:  "$GPDTM,999,CH,0.08,N,0.07,E,-47.7,W84*10",
+		msg: DTM{
+			LocalDatumCode:         UserDefinedDTM,
+			LocalDatumSubcode:      "CH",
+			LatitudeOffsetMinutes:  0.08,
+			LatitudeDirection:      North,
+			LongitudeOffsetMinutes: 0.07,
+			LongitudeDirection:     East,
+			AltitudeOffset:         -47.7,
+			ReferenceDatumCode:     WGS84DTM,
+		},
+	},
+	{
+		name: "bad latitude direction",
+		raw:  "$GPDTM,W84,,0.0,X,0.0,E,0.0,W84*79",
+		err:  "nmea: GPDTM invalid latitude direction: X",
+	},
+}
+
+func TestDTM(t *testing.T) {
+	for _, tt := range dtmtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				dtm := m.(DTM)
+				dtm.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, dtm)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package nmea
+
+// EpochKey returns a key identifying the fix epoch of the sentence, derived
+// from its time of day, so sentences emitted for the same fix (e.g. GGA and
+// RMC) can be grouped together. ok is false if the sentence carries no valid time.
+func EpochKey(s Sentence) (key string, ok bool) {
+	var t Time
+	switch m := s.(type) {
+	case GGA:
+		t = m.Time
+	case GLL:
+		t = m.Time
+	case GNS:
+		t = m.Time
+	case RMC:
+		t = m.Time
+	case ZDA:
+		t = m.Time
+	case GST:
+		t = m.Time
+	case GBS:
+		t = m.Time
+	case GRS:
+		t = m.Time
+	case BWC:
+		t = m.Time
+	case PUBX00:
+		t = m.Time
+	case PUBX04:
+		t = m.Time
+	default:
+		return "", false
+	}
+	if !t.Valid {
+		return "", false
+	}
+	return t.String(), true
+}
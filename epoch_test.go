@@ -0,0 +1,39 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEpochKey(t *testing.T) {
+	raws := []string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GPRMC,034225.077,A,3356.4650,S,15124.5567,E,0.0,0.0,250905,,,A*7C",
+		"$GPGSA,A,3,22,19,18,27,14,03,,,,,,,3.1,2.0,2.4*36",
+		"$GPGST,034225.077,0.006,0.023,0.020,273.6,0.023,0.020,0.031*61",
+		"$GPGBS,034225.077,-0.031,-0.186,0.219,,,,*74",
+		"$GPGRS,034225.077,0,-0.8,-0.2,-0.1,-0.2,0.8,0.6*7A",
+		"$GPBWC,034225.077,4917.24,N,12309.57,W,051.9,T,031.6,M,001.3,N,004*34",
+		"$GPRMC,034226.077,A,3356.4650,S,15124.5567,E,0.0,0.0,250905,,,A*7F",
+	}
+	epochs := map[string][]string{}
+	var untimed []string
+	for _, raw := range raws {
+		s, err := Parse(raw)
+		assert.NoError(t, err)
+		if key, ok := EpochKey(s); ok {
+			epochs[key] = append(epochs[key], s.DataType())
+		} else {
+			untimed = append(untimed, s.DataType())
+		}
+	}
+	assert.Equal(t, map[string][]string{
+		"03:42:25.0770": {TypeGGA, TypeRMC, TypeGST, TypeGBS, TypeGRS, TypeBWC},
+		"03:42:26.0770": {TypeRMC},
+	}, epochs)
+	assert.Equal(t, []string{TypeGSA}, untimed)
+
+	_, ok := EpochKey(GGA{})
+	assert.False(t, ok)
+}
@@ -0,0 +1,59 @@
+package nmea
+
+const (
+	// TypeGBS type for GBS sentences
+	TypeGBS = "GBS"
+)
+
+// GBS is the GNSS satellite fault detection used for receiver autonomous integrity monitoring.
+// The failed satellite fields are empty when no fault is detected.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_gbs_gps_satellite_fault_detection
+type GBS struct {
+	BaseSentence
+	Time                         Time    // UTC time of the associated position fix
+	LatitudeError                float64 // Expected error in latitude in meters
+	LongitudeError               float64 // Expected error in longitude in meters
+	AltitudeError                float64 // Expected error in altitude in meters
+	FailedSatellite              int64   // ID of the most likely failed satellite, 0 if none
+	ProbabilityOfMissedDetection float64 // Probability of missed detection of the failed satellite
+	BiasEstimate                 float64 // Estimated bias of the failed satellite in meters
+	StandardDeviation            float64 // Standard deviation of the bias estimate
+}
+
+func (s GBS) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"time":                            s.Time.String(),
+		"latitude_error":                  s.LatitudeError,
+		"longitude_error":                 s.LongitudeError,
+		"altitude_error":                  s.AltitudeError,
+		"failed_satellite":                s.FailedSatellite,
+		"probability_of_missed_detection": s.ProbabilityOfMissedDetection,
+		"bias_estimate":                   s.BiasEstimate,
+		"standard_deviation":              s.StandardDeviation,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newGBS constructor
+func newGBS(s BaseSentence) (GBS, error) {
+	p := NewParser(s)
+	p.AssertType(TypeGBS)
+	return GBS{
+		BaseSentence:                 s,
+		Time:                         p.Time(0, "time"),
+		LatitudeError:                p.Float64(1, "latitude error"),
+		LongitudeError:               p.Float64(2, "longitude error"),
+		AltitudeError:                p.Float64(3, "altitude error"),
+		FailedSatellite:              p.Int64(4, "failed satellite"),
+		ProbabilityOfMissedDetection: p.Float64(5, "probability of missed detection"),
+		BiasEstimate:                 p.Float64(6, "bias estimate"),
+		StandardDeviation:            p.Float64(7, "standard deviation"),
+	}, p.Err()
+}
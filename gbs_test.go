@@ -0,0 +1,61 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var gbstests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  GBS
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPGBS,015509.00,-0.031,-0.186,0.219,19,0.000,-0.354,6.972*4D",
+		msg: GBS{
+			Time:                         Time{true, 1, 55, 9, 0},
+			LatitudeError:                -0.031,
+			LongitudeError:               -0.186,
+			AltitudeError:                0.219,
+			FailedSatellite:              19,
+			ProbabilityOfMissedDetection: 0,
+			BiasEstimate:                 -0.354,
+			StandardDeviation:            6.972,
+		},
+	},
+	{
+		name: "no failed satellite",
+		raw:  "$GPGBS,015509.00,-0.031,-0.186,0.219,,,,*4E",
+		msg: GBS{
+			Time:           Time{true, 1, 55, 9, 0},
+			LatitudeError:  -0.031,
+			LongitudeError: -0.186,
+			AltitudeError:  0.219,
+		},
+	},
+	{
+		name: "invalid failed satellite",
+		raw:  "$GPGBS,015509.00,-0.031,-0.186,0.219,x,0.000,-0.354,6.972*3D",
+		err:  "nmea: GPGBS invalid failed satellite: x",
+	},
+}
+
+func TestGBS(t *testing.T) {
+	for _, tt := range gbstests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				gbs := m.(GBS)
+				gbs.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, gbs)
+			}
+		})
+	}
+}
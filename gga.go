@@ -1,5 +1,13 @@
 package nmea
 
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+)
+
 const (
 	// TypeGGA type for GGA sentences
 	TypeGGA = "GGA"
@@ -15,6 +23,10 @@ const (
 	RTK = "4"
 	// FRTK float RTK fix
 	FRTK = "5"
+	// DeadReckoning estimated (dead reckoning) fix
+	DeadReckoning = "6"
+	// MetersGGA is the unit of the GGA altitude and geoidal separation
+	MetersGGA = "M"
 )
 
 // GGA is the Time, position, and fix related data of the receiver.
@@ -36,8 +48,8 @@ func (s GGA) ToMap() (map[string]interface{}, error) {
 	m := map[string]interface{}{
 		"time":           s.Time.String(),
 		"time_valid":     s.Time.Valid,
-		"latitude":       s.Latitude,
-		"longitude":      s.Longitude,
+		"latitude":       roundCoordinate(s.Latitude),
+		"longitude":      roundCoordinate(s.Longitude),
 		"fix_quality":    s.FixQuality,
 		"num_satellites": s.NumSatellites,
 		"hdop":           s.HDOP,
@@ -56,21 +68,175 @@ func (s GGA) ToMap() (map[string]interface{}, error) {
 	return m, nil
 }
 
+var (
+	thousandsHeadRe = regexp.MustCompile(`^-?\d{1,3}$`)
+	thousandsTailRe = regexp.MustCompile(`^\d{3}(\.\d*)?$`)
+)
+
+// thousandsSplit reports whether the number at index i was split in two
+// fields by a thousands separator, pushing the unit field expected at
+// index i+1 to index i+2.
+func thousandsSplit(fields []string, i int, unit string) bool {
+	return len(fields) > i+2 &&
+		fields[i+1] != unit && fields[i+2] == unit &&
+		thousandsHeadRe.MatchString(fields[i]) &&
+		thousandsTailRe.MatchString(fields[i+1])
+}
+
 // newGGA constructor
-func newGGA(s BaseSentence) (GGA, error) {
+// localeTolerant rejoins an altitude split by a thousands separator,
+// see ParseOptions.
+func newGGA(s BaseSentence, localeTolerant bool) (GGA, error) {
+	if localeTolerant && thousandsSplit(s.Fields, 8, MetersGGA) {
+		fields := append([]string{}, s.Fields[:8]...)
+		fields = append(fields, s.Fields[8]+s.Fields[9])
+		s.Fields = append(fields, s.Fields[10:]...)
+	}
 	p := NewParser(s)
 	p.AssertType(TypeGGA)
-	return GGA{
+	if thousandsSplit(s.Fields, 8, MetersGGA) {
+		p.SetErr("altitude", s.Fields[8]+FieldSep+s.Fields[9]+" (thousands separator)")
+	}
+	p.EnumString(11, "separation units", MetersGGA)
+	m := GGA{
 		BaseSentence:  s,
 		Time:          p.Time(0, "time"),
-		Latitude:      p.LatLong(1, 2, "latitude"),
-		Longitude:     p.LatLong(3, 4, "longitude"),
-		FixQuality:    p.EnumString(5, "fix quality", Invalid, GPS, DGPS, PPS, RTK, FRTK),
+		FixQuality:    p.EnumString(5, "fix quality", Invalid, GPS, DGPS, PPS, RTK, FRTK, DeadReckoning),
 		NumSatellites: p.Int64(6, "number of satellites"),
 		HDOP:          p.Float64(7, "hdop"),
 		Altitude:      p.Float64(8, "altitude"),
 		Separation:    p.Float64(10, "separation"),
 		DGPSAge:       p.String(12, "dgps age"),
 		DGPSId:        p.String(13, "dgps id"),
-	}, p.Err()
+	}
+	// A receiver without a fix leaves the position fields empty.
+	if m.FieldPresent(1) || m.FieldPresent(2) {
+		m.Latitude = float64(p.Latitude(1, 2, "latitude"))
+	}
+	if m.FieldPresent(3) || m.FieldPresent(4) {
+		m.Longitude = float64(p.Longitude(3, 4, "longitude"))
+	}
+	return m, p.Err()
+}
+
+// ggaQualityRanks orders the fix qualities from worst to best.
+var ggaQualityRanks = map[string]int{
+	DeadReckoning: 1,
+	GPS:           2,
+	DGPS:          3,
+	PPS:           3,
+	FRTK:          4,
+	RTK:           5,
+}
+
+// IsDeadReckoning reports whether the position is estimated by dead reckoning.
+func (s GGA) IsDeadReckoning() bool {
+	return s.FixQuality == DeadReckoning
+}
+
+// IsUsable reports whether the fix quality is at least minQuality, ranking
+// the qualities as DeadReckoning < GPS < DGPS = PPS < FRTK < RTK.
+// An invalid fix is never usable, and no fix is usable for a minQuality
+// other than the constants above.
+func (s GGA) IsUsable(minQuality string) bool {
+	rank, ok := ggaQualityRanks[s.FixQuality]
+	minRank, minOK := ggaQualityRanks[minQuality]
+	return ok && minOK && rank >= minRank
+}
+
+// EllipsoidalHeight returns the height above the WGS84 ellipsoid, the altitude
+// above mean sea level plus the geoidal separation. NaN is returned if either
+// field is absent from the sentence.
+func (s GGA) EllipsoidalHeight() float64 {
+	if !s.FieldPresent(8) || !s.FieldPresent(10) {
+		return math.NaN()
+	}
+	return s.Altitude + s.Separation
+}
+
+// GeoidalSeparationMeters returns the geoidal separation in meters, the only
+// unit allowed for it. NaN is returned if the field is absent from the sentence.
+func (s GGA) GeoidalSeparationMeters() float64 {
+	if !s.FieldPresent(10) {
+		return math.NaN()
+	}
+	return s.Separation
+}
+
+// AttachDate returns the UTC timestamp of the GGA fix on the given date.
+// GGA only carries the time of day, so the date has to be borrowed from
+// another sentence such as RMC or ZDA. The zero time.Time is returned
+// if either the fix time or the date is invalid.
+func AttachDate(gga *GGA, date Date) time.Time {
+	return dateTime(date, gga.Time)
+}
+
+// RenderCanonical formats the sentence using the conventional width and
+// precision of each GGA field (e.g. time as hhmmss.ss and latitude as
+// ddmm.mmmm) and recomputes the checksum. Values with more decimals, such
+// as an HDOP of 99.99, keep them. Fields that were empty, such as the
+// position of a sentence without a fix, stay empty.
+func (s GGA) RenderCanonical() string {
+	hms := ""
+	if s.Time.Valid {
+		hms = formatCanonicalTime(s.Time)
+	}
+	lat, ns := "", ""
+	if s.FieldPresent(1) || s.Latitude != 0 {
+		lat, ns = formatCanonicalGPS(s.Latitude, 2, North, South)
+	}
+	lon, ew := "", ""
+	if s.FieldPresent(3) || s.Longitude != 0 {
+		lon, ew = formatCanonicalGPS(s.Longitude, 3, East, West)
+	}
+	satellites := ""
+	if s.FieldPresent(6) || s.NumSatellites != 0 {
+		satellites = fmt.Sprintf("%02d", s.NumSatellites)
+	}
+	altitude, altitudeUnit := s.canonicalFloat(s.Altitude, 8), s.field(9)
+	if altitude != "" {
+		altitudeUnit = MetersGGA
+	}
+	separation, separationUnit := s.canonicalFloat(s.Separation, 10), s.field(11)
+	if separation != "" {
+		separationUnit = MetersGGA
+	}
+	raw, _ := serialize(s.start(), s.Prefix(), []string{
+		hms,
+		lat, ns,
+		lon, ew,
+		s.FixQuality,
+		satellites,
+		s.canonicalFloat(s.HDOP, 7),
+		altitude, altitudeUnit,
+		separation, separationUnit,
+		s.DGPSAge,
+		s.DGPSId,
+	})
+	return raw
+}
+
+// canonicalFloat formats v with at least one decimal, or returns an empty
+// string if v is zero and the field at index i was empty.
+func (s GGA) canonicalFloat(v float64, i int) string {
+	if v == 0 && !s.FieldPresent(i) {
+		return ""
+	}
+	f := formatFloat(v)
+	if !strings.Contains(f, ".") {
+		f += ".0"
+	}
+	return f
+}
+
+// formatCanonicalTime formats t as hhmmss.ss, rounding the milliseconds
+// to the nearest hundredth of a second.
+func formatCanonicalTime(t Time) string {
+	centis := (t.Millisecond + 5) / 10
+	if centis == 100 {
+		// carry into the seconds, wrapping around midnight
+		total := ((t.Hour*60+t.Minute)*60 + t.Second + 1) % (24 * 60 * 60)
+		t.Hour, t.Minute, t.Second, centis = total/3600, total/60%60, total%60, 0
+	}
+	return fmt.Sprintf("%02d%02d%02d.%02d", t.Hour, t.Minute, t.Second, centis)
 }
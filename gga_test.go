@@ -1,7 +1,9 @@
 package nmea
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -80,6 +82,11 @@ var ggatests = []struct {
 		raw:  "$GPGGA,034225.077,3356.4650,S,15124.5567,E,12,03,9.7,-25.0,M,21.0,M,,0000*63",
 		err:  "nmea: GPGGA invalid fix quality: 12",
 	},
+	{
+		name: "bad separation units",
+		raw:  "$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,F,,0000*5A",
+		err:  "nmea: GPGGA invalid separation units: F",
+	},
 }
 
 func TestGGA(t *testing.T) {
@@ -98,3 +105,153 @@ func TestGGA(t *testing.T) {
 		})
 	}
 }
+
+func TestAttachDate(t *testing.T) {
+	m, err := Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51")
+	assert.NoError(t, err)
+	gga := m.(GGA)
+	m, err = Parse("$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0C")
+	assert.NoError(t, err)
+	rmc := m.(RMC)
+
+	expected := time.Date(2005, time.September, 25, 3, 42, 25, 77*int(time.Millisecond), time.UTC)
+	assert.Equal(t, expected, AttachDate(&gga, rmc.Date))
+	assert.True(t, AttachDate(&gga, Date{}).IsZero())
+	assert.True(t, AttachDate(&GGA{}, rmc.Date).IsZero())
+}
+
+func TestGGAFieldCount(t *testing.T) {
+	m, err := Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51")
+	assert.NoError(t, err)
+	assert.Equal(t, 14, m.FieldCount())
+}
+
+func TestGGARenderCanonical(t *testing.T) {
+	tests := []struct {
+		raw       string
+		canonical string
+	}{
+		{
+			raw:       "$GPGGA,034225,3356.465,S,15124.5567,E,1,3,9.70,-25,M,21,M,,0000*7F",
+			canonical: "$GPGGA,034225.00,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*61",
+		},
+		{
+			raw:       "$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+			canonical: "$GPGGA,034225.08,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*69",
+		},
+		{
+			raw:       "$GNGGA,203415.000,6325.6138,N,01021.4290,E,1,8,2.42,72.5,M,41.5,M,,*7C",
+			canonical: "$GNGGA,203415.00,6325.6138,N,01021.4290,E,1,08,2.42,72.5,M,41.5,M,,*7C",
+		},
+		{
+			raw:       "$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,99.99,-25.0,M,21.0,M,,0000*5F",
+			canonical: "$GPGGA,034225.08,3356.4650,S,15124.5567,E,1,03,99.99,-25.0,M,21.0,M,,0000*67",
+		},
+		{
+			raw:       "$GPGGA,235959.996,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*54",
+			canonical: "$GPGGA,000000.00,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*63",
+		},
+		{
+			raw:       "$GPGGA,,,,,,0,00,99.99,,,,,,*48",
+			canonical: "$GPGGA,,,,,,0,00,99.99,,,,,,*48",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.canonical, m.(GGA).RenderCanonical())
+		})
+	}
+}
+
+func TestGGAToMapCoordinatePrecision(t *testing.T) {
+	m, err := Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51")
+	assert.NoError(t, err)
+
+	fields, err := m.ToMap()
+	assert.NoError(t, err)
+	assert.Equal(t, -33.941083, fields["latitude"])
+	assert.Equal(t, 151.409278, fields["longitude"])
+
+	defer func(precision int) { CoordinatePrecision = precision }(CoordinatePrecision)
+	CoordinatePrecision = 3
+	fields, err = m.ToMap()
+	assert.NoError(t, err)
+	assert.Equal(t, -33.941, fields["latitude"])
+	assert.Equal(t, 151.409, fields["longitude"])
+}
+
+func TestGGAFixQuality(t *testing.T) {
+	m, err := Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,6,03,9.7,-25.0,M,21.0,M,,0000*56")
+	assert.NoError(t, err)
+	assert.True(t, m.(GGA).IsDeadReckoning())
+
+	tests := []struct {
+		quality       string
+		deadReckoning bool
+		usableGPS     bool
+		usableDGPS    bool
+		usableRTK     bool
+	}{
+		{Invalid, false, false, false, false},
+		{DeadReckoning, true, false, false, false},
+		{GPS, false, true, false, false},
+		{DGPS, false, true, true, false},
+		{PPS, false, true, true, false},
+		{FRTK, false, true, true, false},
+		{RTK, false, true, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.quality, func(t *testing.T) {
+			gga := GGA{FixQuality: tt.quality}
+			assert.Equal(t, tt.deadReckoning, gga.IsDeadReckoning())
+			assert.Equal(t, tt.quality != Invalid, gga.IsUsable(DeadReckoning))
+			assert.Equal(t, tt.usableGPS, gga.IsUsable(GPS))
+			assert.Equal(t, tt.usableDGPS, gga.IsUsable(DGPS))
+			assert.Equal(t, tt.usableRTK, gga.IsUsable(RTK))
+			assert.False(t, gga.IsUsable(Invalid))
+			assert.False(t, gga.IsUsable("X"))
+			assert.False(t, gga.IsUsable(""))
+		})
+	}
+}
+
+func TestGGAEllipsoidalHeight(t *testing.T) {
+	m, err := Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51")
+	assert.NoError(t, err)
+	assert.InDelta(t, -4.0, m.(GGA).EllipsoidalHeight(), 0.000001)
+
+	m, err = Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,,M,,0000*4C")
+	assert.NoError(t, err)
+	assert.True(t, math.IsNaN(m.(GGA).EllipsoidalHeight()))
+}
+
+func TestGGAGeoidalSeparationMeters(t *testing.T) {
+	m, err := Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51")
+	assert.NoError(t, err)
+	assert.Equal(t, 21.0, m.(GGA).GeoidalSeparationMeters())
+
+	m, err = Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,,M,,0000*4C")
+	assert.NoError(t, err)
+	assert.True(t, math.IsNaN(m.(GGA).GeoidalSeparationMeters()))
+}
+
+func TestGGAThousandsSeparator(t *testing.T) {
+	raw := "$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,1,234.5,M,21.0,M,,0000*56"
+	_, err := Parse(raw)
+	assert.EqualError(t, err, "nmea: GPGGA invalid altitude: 1,234.5 (thousands separator)")
+
+	opts := ParseOptions{LocaleTolerant: true}
+	m, err := ParseWithOptions(raw, opts)
+	assert.NoError(t, err)
+	gga := m.(GGA)
+	assert.Equal(t, 1234.5, gga.Altitude)
+	assert.Equal(t, 21.0, gga.Separation)
+	assert.Equal(t, "0000", gga.DGPSId)
+	assert.Equal(t, 14, gga.FieldCount())
+
+	m, err = ParseWithOptions("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-1,234.5,M,21.0,M,,0000*7B", opts)
+	assert.NoError(t, err)
+	assert.Equal(t, -1234.5, m.(GGA).Altitude)
+}
@@ -21,8 +21,8 @@ type GLL struct {
 
 func (s GLL) ToMap() (map[string]interface{}, error) {
 	m := map[string]interface{}{
-		"latitude":   s.Latitude,
-		"longitude":  s.Longitude,
+		"latitude":   roundCoordinate(s.Latitude),
+		"longitude":  roundCoordinate(s.Longitude),
 		"time":       s.Time.String(),
 		"time_valid": s.Time.Valid,
 		"validity":   s.Validity,
@@ -38,14 +38,26 @@ func (s GLL) ToMap() (map[string]interface{}, error) {
 }
 
 // newGLL constructor
+// Older receivers omit the time and validity fields, in which case
+// Time is left invalid and Validity empty. Some also omit only the validity.
 func newGLL(s BaseSentence) (GLL, error) {
 	p := NewParser(s)
 	p.AssertType(TypeGLL)
-	return GLL{
+	m := GLL{
 		BaseSentence: s,
-		Latitude:     p.LatLong(0, 1, "latitude"),
-		Longitude:    p.LatLong(2, 3, "longitude"),
-		Time:         p.Time(4, "time"),
-		Validity:     p.EnumString(5, "validity", ValidGLL, InvalidGLL),
-	}, p.Err()
+	}
+	// A receiver without a fix leaves the position fields empty.
+	if m.FieldPresent(0) || m.FieldPresent(1) {
+		m.Latitude = float64(p.Latitude(0, 1, "latitude"))
+	}
+	if m.FieldPresent(2) || m.FieldPresent(3) {
+		m.Longitude = float64(p.Longitude(2, 3, "longitude"))
+	}
+	if len(m.Fields) > 4 {
+		m.Time = p.Time(4, "time")
+	}
+	if len(m.Fields) > 5 {
+		m.Validity = p.EnumString(5, "validity", ValidGLL, InvalidGLL)
+	}
+	return m, p.Err()
 }
@@ -28,11 +28,56 @@ var glltests = []struct {
 			Validity: "A",
 		},
 	},
+	{
+		name: "good sentence without time and validity",
+		raw:  "$GPGLL,3926.7952,N,12000.5947,W*72",
+		msg: GLL{
+			Latitude:  MustParseLatLong("3926.7952 N"),
+			Longitude: MustParseLatLong("12000.5947 W"),
+		},
+	},
+	{
+		name: "good sentence without validity",
+		raw:  "$GPGLL,3926.7952,N,12000.5947,W,022732*58",
+		msg: GLL{
+			Latitude:  MustParseLatLong("3926.7952 N"),
+			Longitude: MustParseLatLong("12000.5947 W"),
+			Time: Time{
+				Valid:  true,
+				Hour:   2,
+				Minute: 27,
+				Second: 32,
+			},
+		},
+	},
+	{
+		name: "no fix",
+		raw:  "$GPGLL,,,,,022732,V,N*62",
+		msg: GLL{
+			Time: Time{
+				Valid:  true,
+				Hour:   2,
+				Minute: 27,
+				Second: 32,
+			},
+			Validity: "V",
+		},
+	},
 	{
 		name: "bad validity",
 		raw:  "$GPGLL,3926.7952,N,12000.5947,W,022732,D,A*5D",
 		err:  "nmea: GPGLL invalid validity: D",
 	},
+	{
+		name: "invalid hemisphere",
+		raw:  "$GPGLL,3926.7952,X,12000.5947,W*64",
+		err:  "nmea: GPGLL invalid latitude: invalid hemisphere [X]",
+	},
+	{
+		name: "missing hemisphere",
+		raw:  "$GPGLL,3926.7952,,12000.5947,W*3C",
+		err:  "nmea: GPGLL invalid latitude: empty field",
+	},
 }
 
 func TestGLL(t *testing.T) {
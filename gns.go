@@ -41,8 +41,8 @@ type GNS struct {
 func (s GNS) ToMap() (map[string]interface{}, error) {
 	m := map[string]interface{}{
 		"time":       s.Time.String(),
-		"latitude":   s.Latitude,
-		"longitude":  s.Longitude,
+		"latitude":   roundCoordinate(s.Latitude),
+		"longitude":  roundCoordinate(s.Longitude),
 		"mode":       s.Mode,
 		"svs":        s.SVs,
 		"hdop":       s.HDOP,
@@ -61,6 +61,35 @@ func (s GNS) ToMap() (map[string]interface{}, error) {
 	return m, nil
 }
 
+// gnsModeNames maps the mode characters to their description.
+var gnsModeNames = map[string]string{
+	NoFixGNS:             "no fix",
+	AutonomousGNS:        "autonomous",
+	DifferentialGNS:      "differential",
+	PreciseGNS:           "precise",
+	RealTimeKinematicGNS: "RTK fixed",
+	FloatRTKGNS:          "RTK float",
+	EstimatedGNS:         "estimated",
+	ManualGNS:            "manual",
+	SimulatorGNS:         "simulator",
+}
+
+// Modes returns the mode character of each constellation
+// (GPS, GLONASS, Galileo, ...) in the order they appear in the sentence.
+func (s GNS) Modes() []byte {
+	modes := make([]byte, 0, len(s.Mode))
+	for _, m := range s.Mode {
+		modes = append(modes, m[0])
+	}
+	return modes
+}
+
+// ModeName returns the description of the mode character b (e.g. "RTK float" for 'F'),
+// or an empty string if it is not a known mode.
+func (s GNS) ModeName(b byte) string {
+	return gnsModeNames[string(b)]
+}
+
 // newGNS Constructor
 func newGNS(s BaseSentence) (GNS, error) {
 	p := NewParser(s)
@@ -68,8 +97,6 @@ func newGNS(s BaseSentence) (GNS, error) {
 	m := GNS{
 		BaseSentence: s,
 		Time:         p.Time(0, "time"),
-		Latitude:     p.LatLong(1, 2, "latitude"),
-		Longitude:    p.LatLong(3, 4, "longitude"),
 		Mode:         p.EnumChars(5, "mode", NoFixGNS, AutonomousGNS, DifferentialGNS, PreciseGNS, RealTimeKinematicGNS, FloatRTKGNS, EstimatedGNS, ManualGNS, SimulatorGNS),
 		SVs:          p.Int64(6, "SVs"),
 		HDOP:         p.Float64(7, "HDOP"),
@@ -78,5 +105,12 @@ func newGNS(s BaseSentence) (GNS, error) {
 		Age:          p.Float64(10, "age"),
 		Station:      p.Int64(11, "station"),
 	}
+	// A receiver without a fix leaves the position fields empty.
+	if m.FieldPresent(1) || m.FieldPresent(2) {
+		m.Latitude = float64(p.Latitude(1, 2, "latitude"))
+	}
+	if m.FieldPresent(3) || m.FieldPresent(4) {
+		m.Longitude = float64(p.Longitude(3, 4, "longitude"))
+	}
 	return m, p.Err()
 }
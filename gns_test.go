@@ -60,6 +60,14 @@ var gnstests = []struct {
 			Station:    0,
 		},
 	},
+	{
+		name: "no fix",
+		raw:  "$GNGNS,014035.00,,,,,NN,00,,,,,*7E",
+		msg: GNS{
+			Time: Time{true, 1, 40, 35, 0},
+			Mode: []string{"N", "N"},
+		},
+	},
 	{
 		name: "bad sentence",
 		raw:  "$GNGNS,094821.0,4849.931307,N,00216.053323,E,AAX,14,0.6,161.5,48.0,,*35",
@@ -83,3 +91,31 @@ func TestGNS(t *testing.T) {
 		})
 	}
 }
+
+func TestGNSModes(t *testing.T) {
+	m, err := Parse("$GNGNS,094821.0,4849.931307,N,00216.053323,E,AAN,14,0.6,161.5,48.0,,*23")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{'A', 'A', 'N'}, m.(GNS).Modes())
+	assert.Equal(t, []byte{}, GNS{}.Modes())
+}
+
+func TestGNSModeName(t *testing.T) {
+	tests := []struct {
+		mode byte
+		name string
+	}{
+		{'N', "no fix"},
+		{'A', "autonomous"},
+		{'D', "differential"},
+		{'P', "precise"},
+		{'R', "RTK fixed"},
+		{'F', "RTK float"},
+		{'E', "estimated"},
+		{'M', "manual"},
+		{'S', "simulator"},
+		{'X', ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.name, GNS{}.ModeName(tt.mode), "mode %c", tt.mode)
+	}
+}
@@ -0,0 +1,145 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GroupAssembler collects sentences sharing a TAG block group ("g:" parameter),
+// as emitted by AIS multiplexers, and returns them once the group is complete.
+// The zero value is ready to use.
+type GroupAssembler struct {
+	// MaxPending limits the number of incomplete groups buffered at once.
+	// When the limit is reached the oldest incomplete group is evicted
+	// to make room for a new one. Zero means no limit.
+	MaxPending int
+	// Timeout is the time after which an incomplete group is dropped,
+	// counted from the arrival of its first sentence. Zero means no timeout.
+	Timeout time.Duration
+
+	pending map[string]*sentenceGroup
+	order   []string // pending group IDs, oldest first
+	evicted int
+	expired int
+	now     func() time.Time // time.Now if nil
+}
+
+// sentenceGroup holds the sentences of an incomplete group.
+type sentenceGroup struct {
+	sentences []Sentence
+	received  int
+	started   time.Time
+}
+
+// Add buffers the sentence and returns the sentences of its group, in group
+// order, once all of them have been received. A sentence without a group
+// is returned immediately on its own. A sentence number received twice
+// for the same group is an error.
+func (a *GroupAssembler) Add(s Sentence) (group []Sentence, done bool, err error) {
+	a.expire()
+	var grouping string
+	if b, ok := s.(interface{ baseSentence() BaseSentence }); ok {
+		grouping = b.baseSentence().TagBlock.Grouping
+	}
+	if grouping == "" {
+		return []Sentence{s}, true, nil
+	}
+	number, total, id, err := parseGrouping(grouping)
+	if err != nil {
+		return nil, false, err
+	}
+	if a.pending == nil {
+		a.pending = map[string]*sentenceGroup{}
+	}
+	g, ok := a.pending[id]
+	if !ok || int64(len(g.sentences)) != total {
+		if ok {
+			a.remove(id)
+		}
+		if a.MaxPending > 0 && len(a.order) >= a.MaxPending {
+			a.remove(a.order[0])
+			a.evicted++
+		}
+		g = &sentenceGroup{sentences: make([]Sentence, total), started: a.clock()}
+		a.pending[id] = g
+		a.order = append(a.order, id)
+	}
+	if g.sentences[number-1] != nil {
+		return nil, false, fmt.Errorf("nmea: tag block duplicate g: %s", grouping)
+	}
+	g.sentences[number-1] = s
+	g.received++
+	if g.received < len(g.sentences) {
+		return nil, false, nil
+	}
+	a.remove(id)
+	return g.sentences, true, nil
+}
+
+// PendingCount returns the number of incomplete groups currently buffered.
+func (a *GroupAssembler) PendingCount() int {
+	return len(a.order)
+}
+
+// EvictedCount returns the number of incomplete groups dropped
+// because the MaxPending limit was reached.
+func (a *GroupAssembler) EvictedCount() int {
+	return a.evicted
+}
+
+// ExpiredCount returns the number of incomplete groups dropped
+// because they were not completed within the Timeout.
+func (a *GroupAssembler) ExpiredCount() int {
+	return a.expired
+}
+
+// expire drops the incomplete groups older than the Timeout.
+func (a *GroupAssembler) expire() {
+	if a.Timeout <= 0 {
+		return
+	}
+	now := a.clock()
+	for len(a.order) > 0 && now.Sub(a.pending[a.order[0]].started) > a.Timeout {
+		a.remove(a.order[0])
+		a.expired++
+	}
+}
+
+func (a *GroupAssembler) clock() time.Time {
+	if a.now != nil {
+		return a.now()
+	}
+	return time.Now()
+}
+
+// remove drops the pending group with the given ID.
+func (a *GroupAssembler) remove(id string) {
+	delete(a.pending, id)
+	for i, k := range a.order {
+		if k == id {
+			a.order = append(a.order[:i], a.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// maxGroupSentences is the largest number of sentences accepted in a TAG block group.
+const maxGroupSentences = 99
+
+// parseGrouping splits a TAG block group of the form number-total-id (e.g. 1-2-73874).
+// A total above maxGroupSentences is rejected so the sender cannot make
+// the assembler allocate an arbitrarily large buffer.
+func parseGrouping(g string) (number, total int64, id string, err error) {
+	parts := strings.Split(g, "-")
+	if len(parts) != 3 {
+		return 0, 0, "", fmt.Errorf("nmea: tag block invalid g: %s", g)
+	}
+	number, errNumber := strconv.ParseInt(parts[0], 10, 64)
+	total, errTotal := strconv.ParseInt(parts[1], 10, 64)
+	if errNumber != nil || errTotal != nil || total < 1 || total > maxGroupSentences || number < 1 || number > total {
+		return 0, 0, "", fmt.Errorf("nmea: tag block invalid g: %s", g)
+	}
+	return number, total, parts[2], nil
+}
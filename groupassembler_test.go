@@ -0,0 +1,118 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupAssembler(t *testing.T) {
+	first, err := Parse(`\g:1-2-1234*5A\!AIVDM,1,1,,B,15N4cJ` + "`" + `005Jrek0H@9n` + "`" + `DW5608EP,0*13`)
+	assert.NoError(t, err)
+	second, err := Parse(`\g:2-2-1234*59\$INTHS,123.456,A*20`)
+	assert.NoError(t, err)
+
+	var a GroupAssembler
+	group, done, err := a.Add(second)
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.Nil(t, group)
+	assert.Equal(t, 1, a.PendingCount())
+
+	group, done, err = a.Add(first)
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, []Sentence{first, second}, group)
+	assert.Equal(t, 0, a.PendingCount())
+}
+
+func TestGroupAssemblerUngrouped(t *testing.T) {
+	s, err := Parse("$INTHS,123.456,A*20")
+	assert.NoError(t, err)
+
+	var a GroupAssembler
+	group, done, err := a.Add(s)
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, []Sentence{s}, group)
+}
+
+func TestGroupAssemblerInvalidGroup(t *testing.T) {
+	s, err := Parse(`\g:1-x-1*25\$INTHS,123.456,A*20`)
+	assert.NoError(t, err)
+
+	var a GroupAssembler
+	_, done, err := a.Add(s)
+	assert.False(t, done)
+	assert.EqualError(t, err, "nmea: tag block invalid g: 1-x-1")
+}
+
+// mustParseGrouped parses a THS sentence with a TAG block carrying the given group.
+func mustParseGrouped(t *testing.T, g string) Sentence {
+	tags := "g:" + g
+	s, err := Parse(`\` + tags + "*" + xorChecksum(tags) + `\$INTHS,123.456,A*20`)
+	assert.NoError(t, err)
+	return s
+}
+
+func TestGroupAssemblerGroupTooLarge(t *testing.T) {
+	var a GroupAssembler
+	for _, g := range []string{"1-9999999999999-5", "1-100-5", "0-2-5", "3-2-5"} {
+		_, done, err := a.Add(mustParseGrouped(t, g))
+		assert.False(t, done)
+		assert.EqualError(t, err, "nmea: tag block invalid g: "+g)
+	}
+	assert.Equal(t, 0, a.PendingCount())
+}
+
+func TestGroupAssemblerDuplicate(t *testing.T) {
+	var a GroupAssembler
+	_, _, err := a.Add(mustParseGrouped(t, "1-2-7"))
+	assert.NoError(t, err)
+	_, done, err := a.Add(mustParseGrouped(t, "1-2-7"))
+	assert.False(t, done)
+	assert.EqualError(t, err, "nmea: tag block duplicate g: 1-2-7")
+	assert.Equal(t, 1, a.PendingCount())
+}
+
+func TestGroupAssemblerMaxPending(t *testing.T) {
+	a := GroupAssembler{MaxPending: 2}
+	for _, g := range []string{"1-2-1", "1-2-2", "1-2-3"} {
+		_, done, err := a.Add(mustParseGrouped(t, g))
+		assert.NoError(t, err)
+		assert.False(t, done)
+	}
+	assert.Equal(t, 2, a.PendingCount())
+	assert.Equal(t, 1, a.EvictedCount())
+
+	// the oldest group (id 1) was evicted, so its second sentence
+	// starts a new incomplete group instead of completing it.
+	_, done, err := a.Add(mustParseGrouped(t, "2-2-1"))
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.Equal(t, 2, a.PendingCount())
+	assert.Equal(t, 2, a.EvictedCount())
+
+	// the newest group (id 3) is still buffered.
+	group, done, err := a.Add(mustParseGrouped(t, "2-2-3"))
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Len(t, group, 2)
+	assert.Equal(t, 1, a.PendingCount())
+}
+
+func TestGroupAssemblerTimeout(t *testing.T) {
+	now := time.Unix(1000, 0)
+	a := GroupAssembler{Timeout: time.Second, now: func() time.Time { return now }}
+
+	_, _, err := a.Add(mustParseGrouped(t, "1-2-7"))
+	assert.NoError(t, err)
+
+	now = now.Add(2 * time.Second)
+	_, done, err := a.Add(mustParseGrouped(t, "2-2-7"))
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.Equal(t, 1, a.PendingCount())
+	assert.Equal(t, 1, a.ExpiredCount())
+}
@@ -0,0 +1,49 @@
+package nmea
+
+const (
+	// TypeGRS type for GRS sentences
+	TypeGRS = "GRS"
+)
+
+// GRS holds the GNSS range residuals of the satellites used in the navigation solution,
+// in the order of the satellite IDs of the GSA sentence. Absent residuals are zero.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_grs_gps_range_residuals
+type GRS struct {
+	BaseSentence
+	Time      Time        // UTC time of the associated position fix
+	Mode      int64       // 0 if the residuals were used to calculate the GGA position, 1 if recomputed after it
+	Residuals [12]float64 // Range residuals in meters
+}
+
+func (s GRS) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"time":      s.Time.String(),
+		"mode":      s.Mode,
+		"residuals": s.Residuals,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newGRS constructor
+func newGRS(s BaseSentence) (GRS, error) {
+	p := NewParser(s)
+	p.AssertType(TypeGRS)
+	m := GRS{
+		BaseSentence: s,
+		Time:         p.Time(0, "time"),
+		Mode:         p.Int64(1, "mode"),
+	}
+	for i := range m.Residuals {
+		if 2+i < len(s.Fields) {
+			m.Residuals[i] = p.Float64(2+i, "residual")
+		}
+	}
+	return m, p.Err()
+}
@@ -0,0 +1,55 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var grstests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  GRS
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPGRS,220320.0,0,-0.8,-0.2,-0.1,-0.2,0.8,0.6,,,,,,*79",
+		msg: GRS{
+			Time:      Time{true, 22, 3, 20, 0},
+			Mode:      0,
+			Residuals: [12]float64{-0.8, -0.2, -0.1, -0.2, 0.8, 0.6},
+		},
+	},
+	{
+		name: "trailing fields omitted",
+		raw:  "$GPGRS,220320.0,0,-0.8,-0.2,-0.1,-0.2,0.8,0.6*79",
+		msg: GRS{
+			Time:      Time{true, 22, 3, 20, 0},
+			Mode:      0,
+			Residuals: [12]float64{-0.8, -0.2, -0.1, -0.2, 0.8, 0.6},
+		},
+	},
+	{
+		name: "invalid residual",
+		raw:  "$GPGRS,220320.0,0,-0.8,x,-0.1,-0.2,0.8,0.6,,,,,,*00",
+		err:  "nmea: GPGRS invalid residual: x",
+	},
+}
+
+func TestGRS(t *testing.T) {
+	for _, tt := range grstests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				grs := m.(GRS)
+				grs.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, grs)
+			}
+		})
+	}
+}
@@ -46,6 +46,11 @@ func (s GSA) ToMap() (map[string]interface{}, error) {
 	return m, nil
 }
 
+// SatelliteCount returns the number of satellites used for this fix.
+func (s GSA) SatelliteCount() int {
+	return len(s.SV)
+}
+
 // newGSA parses the GSA sentence into this struct.
 func newGSA(s BaseSentence) (GSA, error) {
 	p := NewParser(s)
@@ -52,3 +52,10 @@ func TestGSA(t *testing.T) {
 		})
 	}
 }
+
+func TestGSASatelliteCount(t *testing.T) {
+	m, err := Parse("$GPGSA,A,3,22,19,18,27,14,03,07,31,,,,,1.8,1.0,1.5*39")
+	assert.NoError(t, err)
+	assert.Equal(t, 8, m.(GSA).SatelliteCount())
+	assert.Equal(t, 0, GSA{}.SatelliteCount())
+}
@@ -0,0 +1,58 @@
+package nmea
+
+const (
+	// TypeGST type for GST sentences
+	TypeGST = "GST"
+)
+
+// GST is the GNSS pseudorange error statistics.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_gst_gps_pseudorange_noise_statistics
+type GST struct {
+	BaseSentence
+	Time             Time    // UTC time of the associated position fix
+	RMS              float64 // RMS value of the standard deviation of the range inputs
+	StdDevMajor      float64 // Standard deviation of the semi-major axis of the error ellipse in meters
+	StdDevMinor      float64 // Standard deviation of the semi-minor axis of the error ellipse in meters
+	OrientationMajor float64 // Orientation of the semi-major axis of the error ellipse in degrees from true north
+	StdDevLatitude   float64 // Standard deviation of the latitude error in meters
+	StdDevLongitude  float64 // Standard deviation of the longitude error in meters
+	StdDevAltitude   float64 // Standard deviation of the altitude error in meters
+}
+
+func (s GST) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"time":              s.Time.String(),
+		"rms":               s.RMS,
+		"std_dev_major":     s.StdDevMajor,
+		"std_dev_minor":     s.StdDevMinor,
+		"orientation_major": s.OrientationMajor,
+		"std_dev_latitude":  s.StdDevLatitude,
+		"std_dev_longitude": s.StdDevLongitude,
+		"std_dev_altitude":  s.StdDevAltitude,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newGST constructor
+func newGST(s BaseSentence) (GST, error) {
+	p := NewParser(s)
+	p.AssertType(TypeGST)
+	return GST{
+		BaseSentence:     s,
+		Time:             p.Time(0, "time"),
+		RMS:              p.Float64(1, "RMS"),
+		StdDevMajor:      p.Float64(2, "standard deviation of semi-major axis"),
+		StdDevMinor:      p.Float64(3, "standard deviation of semi-minor axis"),
+		OrientationMajor: p.Float64(4, "orientation of semi-major axis"),
+		StdDevLatitude:   p.Float64(5, "standard deviation of latitude error"),
+		StdDevLongitude:  p.Float64(6, "standard deviation of longitude error"),
+		StdDevAltitude:   p.Float64(7, "standard deviation of altitude error"),
+	}, p.Err()
+}
@@ -0,0 +1,51 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var gsttests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  GST
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPGST,172814.0,0.006,0.023,0.020,273.6,0.023,0.020,0.031*6A",
+		msg: GST{
+			Time:             Time{true, 17, 28, 14, 0},
+			RMS:              0.006,
+			StdDevMajor:      0.023,
+			StdDevMinor:      0.020,
+			OrientationMajor: 273.6,
+			StdDevLatitude:   0.023,
+			StdDevLongitude:  0.020,
+			StdDevAltitude:   0.031,
+		},
+	},
+	{
+		name: "invalid RMS",
+		raw:  "$GPGST,172814.0,x,0.023,0.020,273.6,0.023,0.020,0.031*3A",
+		err:  "nmea: GPGST invalid RMS: x",
+	},
+}
+
+func TestGST(t *testing.T) {
+	for _, tt := range gsttests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				gst := m.(GST)
+				gst.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, gst)
+			}
+		})
+	}
+}
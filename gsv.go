@@ -1,5 +1,7 @@
 package nmea
 
+import "strconv"
+
 const (
 	// TypeGSV type for GSV sentences
 	TypeGSV = "GSV"
@@ -23,6 +25,47 @@ type GSVInfo struct {
 	SNR         int64 // SNR, 00-99 dB (null when not tracking)
 }
 
+// SatellitePosition is the sky position of a visible satellite, suitable for
+// drawing a skyplot.
+type SatellitePosition struct {
+	PRN          int     // SV PRN number
+	ElevationDeg float64 // Elevation in degrees, 90 maximum
+	AzimuthDeg   float64 // Azimuth, degrees from true north, 000 to 359
+	SNR          int     // SNR, 00-99 dB (0 when not tracking)
+	InUse        bool    // Satellite is used in the fix
+}
+
+// Satellites returns the sky positions of the satellites in this message.
+// InUse is always false, use SatellitesWithUsage to cross-reference a GSA.
+func (s GSV) Satellites() []SatellitePosition {
+	return s.SatellitesWithUsage(nil)
+}
+
+// SatellitesWithUsage returns the sky positions of the satellites in this
+// message, marking those listed in the GSA as used in the fix.
+// The GSA may be nil.
+func (s GSV) SatellitesWithUsage(gsa *GSA) []SatellitePosition {
+	used := map[int64]bool{}
+	if gsa != nil {
+		for _, sv := range gsa.SV {
+			if prn, err := strconv.ParseInt(sv, 10, 64); err == nil {
+				used[prn] = true
+			}
+		}
+	}
+	sats := make([]SatellitePosition, len(s.Info))
+	for i, info := range s.Info {
+		sats[i] = SatellitePosition{
+			PRN:          int(info.SVPRNNumber),
+			ElevationDeg: float64(info.Elevation),
+			AzimuthDeg:   float64(info.Azimuth),
+			SNR:          int(info.SNR),
+			InUse:        used[info.SVPRNNumber],
+		}
+	}
+	return sats
+}
+
 func (s GSV) ToMap() (map[string]interface{}, error) {
 	infos := make([]map[string]interface{}, len(s.Info))
 	for idx, info := range s.Info {
@@ -158,3 +158,24 @@ func TestGSV(t *testing.T) {
 		})
 	}
 }
+
+func TestGSVSatellites(t *testing.T) {
+	m, err := Parse("$GLGSV,3,1,11,03,03,111,00,04,15,270,00,06,01,010,12,13,06,292,00*6B")
+	assert.NoError(t, err)
+	gsv := m.(GSV)
+
+	assert.Equal(t, []SatellitePosition{
+		{PRN: 3, ElevationDeg: 3, AzimuthDeg: 111, SNR: 0},
+		{PRN: 4, ElevationDeg: 15, AzimuthDeg: 270, SNR: 0},
+		{PRN: 6, ElevationDeg: 1, AzimuthDeg: 10, SNR: 12},
+		{PRN: 13, ElevationDeg: 6, AzimuthDeg: 292, SNR: 0},
+	}, gsv.Satellites())
+
+	gsa := GSA{SV: []string{"06", "13", "22"}}
+	assert.Equal(t, []SatellitePosition{
+		{PRN: 3, ElevationDeg: 3, AzimuthDeg: 111, SNR: 0},
+		{PRN: 4, ElevationDeg: 15, AzimuthDeg: 270, SNR: 0},
+		{PRN: 6, ElevationDeg: 1, AzimuthDeg: 10, SNR: 12, InUse: true},
+		{PRN: 13, ElevationDeg: 6, AzimuthDeg: 292, SNR: 0, InUse: true},
+	}, gsv.SatellitesWithUsage(&gsa))
+}
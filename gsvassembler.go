@@ -0,0 +1,58 @@
+package nmea
+
+// MultiGSVAssembler reassembles the satellites in view reported by GSV
+// bursts, independently for each talker (GP, GL, GA, ...), so interleaved
+// bursts from several constellations don't corrupt each other.
+// The zero value is ready to use.
+type MultiGSVAssembler struct {
+	pending  map[string]*gsvBurst
+	complete map[string][]GSVInfo
+}
+
+// gsvBurst holds the messages received so far for one talker's burst.
+type gsvBurst struct {
+	next int64 // next expected message number
+	info []GSVInfo
+}
+
+// Add buffers the GSV message and reports whether it completed the burst
+// of its talker. A message received out of sequence discards the
+// incomplete burst of its talker.
+func (a *MultiGSVAssembler) Add(s GSV) bool {
+	if a.pending == nil {
+		a.pending = map[string]*gsvBurst{}
+		a.complete = map[string][]GSVInfo{}
+	}
+	talker := s.TalkerID()
+	burst, ok := a.pending[talker]
+	if s.MessageNumber == 1 || !ok || burst.next != s.MessageNumber {
+		delete(a.pending, talker)
+		if s.MessageNumber != 1 {
+			return false
+		}
+		burst = &gsvBurst{next: 1}
+		a.pending[talker] = burst
+	}
+	burst.info = append(burst.info, s.Info...)
+	burst.next++
+	if s.MessageNumber < s.TotalMessages {
+		return false
+	}
+	delete(a.pending, talker)
+	a.complete[talker] = burst.info
+	return true
+}
+
+// Satellites returns the satellites of the last complete burst of the talker.
+func (a *MultiGSVAssembler) Satellites(talker string) []GSVInfo {
+	return a.complete[talker]
+}
+
+// AllSatellites returns the satellites of the last complete burst of every talker.
+func (a *MultiGSVAssembler) AllSatellites() map[string][]GSVInfo {
+	all := make(map[string][]GSVInfo, len(a.complete))
+	for talker, info := range a.complete {
+		all[talker] = info
+	}
+	return all
+}
@@ -0,0 +1,46 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiGSVAssembler(t *testing.T) {
+	raws := []struct {
+		raw  string
+		done bool
+	}{
+		{"$GPGSV,2,1,05,01,40,083,46,02,17,308,41,12,07,344,39,14,22,228,45*78", false},
+		{"$GLGSV,2,1,06,65,64,037,41,66,29,306,39,72,17,260,35,74,10,089,32*67", false},
+		{"$GPGSV,2,2,05,32,10,120,30*4C", true},
+		{"$GAGSV,2,2,06,11,44,142,40*5D", false},
+		{"$GLGSV,2,2,06,75,44,142,40,76,21,207,37*61", true},
+	}
+	var a MultiGSVAssembler
+	for _, tt := range raws {
+		m, err := Parse(tt.raw)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.done, a.Add(m.(GSV)), tt.raw)
+	}
+
+	assert.Equal(t, map[string][]GSVInfo{
+		"GP": {
+			{SVPRNNumber: 1, Elevation: 40, Azimuth: 83, SNR: 46},
+			{SVPRNNumber: 2, Elevation: 17, Azimuth: 308, SNR: 41},
+			{SVPRNNumber: 12, Elevation: 7, Azimuth: 344, SNR: 39},
+			{SVPRNNumber: 14, Elevation: 22, Azimuth: 228, SNR: 45},
+			{SVPRNNumber: 32, Elevation: 10, Azimuth: 120, SNR: 30},
+		},
+		"GL": {
+			{SVPRNNumber: 65, Elevation: 64, Azimuth: 37, SNR: 41},
+			{SVPRNNumber: 66, Elevation: 29, Azimuth: 306, SNR: 39},
+			{SVPRNNumber: 72, Elevation: 17, Azimuth: 260, SNR: 35},
+			{SVPRNNumber: 74, Elevation: 10, Azimuth: 89, SNR: 32},
+			{SVPRNNumber: 75, Elevation: 44, Azimuth: 142, SNR: 40},
+			{SVPRNNumber: 76, Elevation: 21, Azimuth: 207, SNR: 37},
+		},
+	}, a.AllSatellites())
+	assert.Len(t, a.Satellites("GP"), 5)
+	assert.Nil(t, a.Satellites("GA"))
+}
@@ -3,6 +3,10 @@ package nmea
 const (
 	// TypeHBT type for HBT sentences
 	TypeHBT = "HBT"
+	// NormalHBT equipment in normal operation
+	NormalHBT = "A"
+	// AbnormalHBT equipment not in normal operation
+	AbnormalHBT = "V"
 )
 
 // HBT heartheat supervision sentence
@@ -30,6 +34,17 @@ func (s HBT) ToMap() (map[string]interface{}, error) {
 	return m, nil
 }
 
+// Encode formats the sentence into a checksummed raw sentence.
+// The interval keeps the decimals it was parsed with.
+func (s HBT) Encode() (string, error) {
+	return Encode([]string{
+		s.Prefix(),
+		formatFloatAs(s.Interval, s.field(0)),
+		s.Status,
+		s.ID,
+	})
+}
+
 // newHBT constructor
 func newHBT(s BaseSentence) (HBT, error) {
 	p := NewParser(s)
@@ -37,7 +52,7 @@ func newHBT(s BaseSentence) (HBT, error) {
 	m := HBT{
 		BaseSentence: s,
 		Interval:     p.Float64(0, "Interval"),
-		Status:       p.String(1, "Status"),
+		Status:       p.EnumString(1, "Status", NormalHBT, AbnormalHBT),
 		ID:           p.String(2, "ID"),
 	}
 	return m, p.Err()
@@ -40,3 +40,34 @@ func Test_newHBT(t *testing.T) {
 		})
 	}
 }
+
+func TestHBT_Encode(t *testing.T) {
+	for _, raw := range []string{
+		makeSentence("$BDHBT,100.1,A,9"),
+		makeSentence("$GPHBT,60.0,A,1"),
+		makeSentence("$GPHBT,,V,"),
+	} {
+		t.Run(raw, func(t *testing.T) {
+			m, err := Parse(raw)
+			if err != nil {
+				t.Errorf("newHBT() error = %v", err)
+				return
+			}
+			got, err := m.(HBT).Encode()
+			if err != nil {
+				t.Errorf("HBT.Encode() error = %v", err)
+				return
+			}
+			if got != raw {
+				t.Errorf("HBT.Encode() = %v, want %v", got, raw)
+			}
+		})
+	}
+}
+
+func TestHBT_InvalidStatus(t *testing.T) {
+	_, err := Parse(makeSentence("$BDHBT,100.1,X,9"))
+	if err == nil || err.Error() != "nmea: BDHBT invalid Status: X" {
+		t.Errorf("newHBT() error = %v, want %v", err, "nmea: BDHBT invalid Status: X")
+	}
+}
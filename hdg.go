@@ -1,5 +1,7 @@
 package nmea
 
+import "math"
+
 const (
 	// TypeHDG type for HDG sentences
 	TypeHDG = "HDG"
@@ -34,6 +36,46 @@ func (s HDG) ToMap() (map[string]interface{}, error) {
 	return m, nil
 }
 
+// HeadingReport bundles the magnetic sensor heading of a HDG sentence with
+// its corrections. Deviation and variation are signed, positive easterly.
+type HeadingReport struct {
+	MagneticHeading float64 // Magnetic sensor heading in degrees
+	Deviation       float64 // Magnetic deviation in degrees
+	HasDeviation    bool    // Deviation is reported
+	Variation       float64 // Magnetic variation in degrees
+	HasVariation    bool    // Variation is reported
+	TrueHeading     float64 // Heading corrected by the reported deviation and variation, in [0, 360)
+}
+
+// Report returns the heading report of the sentence. Missing deviation or
+// variation are left out of the computed true heading.
+func (s HDG) Report() HeadingReport {
+	r := HeadingReport{
+		MagneticHeading: s.Heading,
+		HasDeviation:    s.DeviationDirection != "",
+		HasVariation:    s.VariationDirection != "",
+	}
+	if r.HasDeviation {
+		r.Deviation = signedHDG(s.Deviation, s.DeviationDirection)
+	}
+	if r.HasVariation {
+		r.Variation = signedHDG(s.Variation, s.VariationDirection)
+	}
+	r.TrueHeading = math.Mod(s.Heading+r.Deviation+r.Variation, 360)
+	if r.TrueHeading < 0 {
+		r.TrueHeading += 360
+	}
+	return r
+}
+
+// signedHDG returns the correction negated if its direction is West.
+func signedHDG(v float64, direction string) float64 {
+	if direction == West {
+		return -v
+	}
+	return v
+}
+
 // newHDG constructor
 func newHDG(s BaseSentence) (HDG, error) {
 	p := NewParser(s)
@@ -42,9 +84,9 @@ func newHDG(s BaseSentence) (HDG, error) {
 		BaseSentence:       s,
 		Heading:            p.Float64(0, "Heading"),
 		Deviation:          p.Float64(1, "Deviation"),
-		DeviationDirection: p.String(2, "DeviationDirection"),
+		DeviationDirection: p.EnumString(2, "DeviationDirection", East, West),
 		Variation:          p.Float64(3, "Variation"),
-		VariationDirection: p.String(4, "VariationDirection"),
+		VariationDirection: p.EnumString(4, "VariationDirection", East, West),
 	}
 	return m, p.Err()
 }
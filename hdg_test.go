@@ -42,3 +42,73 @@ func Test_newHDG(t *testing.T) {
 		})
 	}
 }
+
+func TestHDG_Report(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want HeadingReport
+	}{
+		{
+			name: "full data",
+			raw:  makeSentence("$HCHDG,98.3,0.6,E,12.6,W"),
+			want: HeadingReport{
+				MagneticHeading: 98.3,
+				Deviation:       0.6,
+				HasDeviation:    true,
+				Variation:       -12.6,
+				HasVariation:    true,
+				TrueHeading:     86.3,
+			},
+		},
+		{
+			name: "variation only",
+			raw:  makeSentence("$HCHDG,355.0,,,10.0,E"),
+			want: HeadingReport{
+				MagneticHeading: 355,
+				Variation:       10,
+				HasVariation:    true,
+				TrueHeading:     5,
+			},
+		},
+		{
+			name: "heading only",
+			raw:  makeSentence("$HCHDG,5.0,,,,"),
+			want: HeadingReport{
+				MagneticHeading: 5,
+				TrueHeading:     5,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if err != nil {
+				t.Errorf("newHDG() error = %v", err)
+				return
+			}
+			got := m.(HDG).Report()
+			if diff := deep.Equal(got, tt.want); diff != nil {
+				t.Errorf("HDG.Report() = %#v, want %#v, dif = %v", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestHDG_InvalidDirection(t *testing.T) {
+	tests := []struct {
+		raw string
+		err string
+	}{
+		{makeSentence("$BDHDG,5.0,100.1,X,9.00,W"), "nmea: BDHDG invalid DeviationDirection: X"},
+		{makeSentence("$BDHDG,5.0,100.1,E,9.00,N"), "nmea: BDHDG invalid VariationDirection: N"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			_, err := Parse(tt.raw)
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("newHDG() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -39,3 +39,14 @@ func newHDT(s BaseSentence) (HDT, error) {
 	}
 	return m, p.Err()
 }
+
+// Render formats the sentence back into a checksummed raw sentence.
+// The heading keeps the decimals and leading zeros of the parsed field.
+func (s HDT) Render() string {
+	t := ""
+	if s.True {
+		t = "T"
+	}
+	raw, _ := serialize(s.start(), s.Prefix(), []string{formatFloatAs(s.Heading, s.field(0)), t})
+	return raw
+}
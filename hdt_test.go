@@ -48,3 +48,18 @@ func TestHDT(t *testing.T) {
 		})
 	}
 }
+
+func TestHDTRender(t *testing.T) {
+	for _, raw := range []string{
+		"$HEHDT,123.456,T*28",
+		"$HEHDT,5,T*34",
+		"$HEHDT,123.450,T*2E",
+		"$HEHDT,010.0,T*2E",
+		"$HEHDT,,T*01",
+		"$GPHDT,274.07,T*03",
+	} {
+		m, err := Parse(raw)
+		assert.NoError(t, err)
+		assert.Equal(t, raw, m.(HDT).Render())
+	}
+}
@@ -0,0 +1,42 @@
+package nmea
+
+const (
+	// TypeMTW type for MTW sentences
+	TypeMTW = "MTW"
+	// CelsiusMTW is MTW temperature unit
+	CelsiusMTW = "C"
+)
+
+// MTW is the mean temperature of water.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_mtw_mean_temperature_of_water
+type MTW struct {
+	BaseSentence
+	Temperature float64 // Temperature in degrees
+	CelsiusUnit string  // Unit of measurement, C = degrees Celsius
+}
+
+func (s MTW) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"temperature":  s.Temperature,
+		"celsius_unit": s.CelsiusUnit,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newMTW constructor
+func newMTW(s BaseSentence) (MTW, error) {
+	p := NewParser(s)
+	p.AssertType(TypeMTW)
+	return MTW{
+		BaseSentence: s,
+		Temperature:  p.Float64(0, "temperature"),
+		CelsiusUnit:  p.String(1, "unit"),
+	}, p.Err()
+}
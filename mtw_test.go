@@ -0,0 +1,55 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mtwtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  MTW
+}{
+	{
+		name: "good sentence",
+		raw:  "$YXMTW,17.5,C*11",
+		msg: MTW{
+			Temperature: 17.5,
+			CelsiusUnit: CelsiusMTW,
+		},
+	},
+	{
+		name: "invalid temperature",
+		raw:  "$YXMTW,x,C*74",
+		err:  "nmea: YXMTW invalid temperature: x",
+	},
+}
+
+func TestMTW(t *testing.T) {
+	for _, tt := range mtwtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				mtw := m.(MTW)
+				mtw.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, mtw)
+			}
+		})
+	}
+}
+
+func TestMTWToMap(t *testing.T) {
+	m, err := Parse("$YXMTW,17.5,C*11")
+	assert.NoError(t, err)
+	fields, err := m.ToMap()
+	assert.NoError(t, err)
+	assert.Equal(t, 17.5, fields["temperature"])
+	assert.Equal(t, "C", fields["celsius_unit"])
+	assert.Equal(t, "MTW", fields["type"])
+}
@@ -0,0 +1,60 @@
+package nmea
+
+const (
+	// TypeMWD type for MWD sentences
+	TypeMWD = "MWD"
+)
+
+// MWD is the wind direction and speed.
+// Either of the true and magnetic directions may be left empty
+// by sensors that only report one of them.
+// https://www.tronico.fi/OH6NT/docs/NMEA0183.pdf
+type MWD struct {
+	BaseSentence
+	WindDirectionTrue     float64 // True wind direction in degrees
+	TrueDirectionUnit     string  // T = true
+	WindDirectionMagnetic float64 // Magnetic wind direction in degrees
+	MagneticDirectionUnit string  // M = magnetic
+	WindSpeedKnots        float64 // Wind speed in knots
+	WindSpeedKnotsUnit    string  // N = knots
+	WindSpeedMeters       float64 // Wind speed in meters per second
+	WindSpeedMetersUnit   string  // M = meters per second
+}
+
+func (s MWD) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"wind_direction_true":     s.WindDirectionTrue,
+		"true_direction_unit":     s.TrueDirectionUnit,
+		"wind_direction_magnetic": s.WindDirectionMagnetic,
+		"magnetic_direction_unit": s.MagneticDirectionUnit,
+		"wind_speed_knots":        s.WindSpeedKnots,
+		"wind_speed_knots_unit":   s.WindSpeedKnotsUnit,
+		"wind_speed_meters":       s.WindSpeedMeters,
+		"wind_speed_meters_unit":  s.WindSpeedMetersUnit,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newMWD constructor
+func newMWD(s BaseSentence) (MWD, error) {
+	p := NewParser(s)
+	p.AssertType(TypeMWD)
+	return MWD{
+		BaseSentence:          s,
+		WindDirectionTrue:     p.Float64(0, "true wind direction"),
+		TrueDirectionUnit:     p.String(1, "true wind direction unit"),
+		WindDirectionMagnetic: p.Float64(2, "magnetic wind direction"),
+		MagneticDirectionUnit: p.String(3, "magnetic wind direction unit"),
+		WindSpeedKnots:        p.Float64(4, "wind speed (knots)"),
+		WindSpeedKnotsUnit:    p.String(5, "wind speed (knots) unit"),
+		WindSpeedMeters:       p.Float64(6, "wind speed (m/s)"),
+		WindSpeedMetersUnit:   p.String(7, "wind speed (m/s) unit"),
+	}, p.Err()
+}
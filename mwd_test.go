@@ -0,0 +1,64 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mwdtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  MWD
+}{
+	{
+		name: "good sentence",
+		raw:  "$WIMWD,290.0,T,280.0,M,10.0,N,5.1,M*6E",
+		msg: MWD{
+			WindDirectionTrue:     290,
+			TrueDirectionUnit:     "T",
+			WindDirectionMagnetic: 280,
+			MagneticDirectionUnit: "M",
+			WindSpeedKnots:        10,
+			WindSpeedKnotsUnit:    "N",
+			WindSpeedMeters:       5.1,
+			WindSpeedMetersUnit:   "M",
+		},
+	},
+	{
+		name: "empty true direction",
+		raw:  "$WIMWD,,T,280.0,M,10.0,N,5.1,M*4B",
+		msg: MWD{
+			TrueDirectionUnit:     "T",
+			WindDirectionMagnetic: 280,
+			MagneticDirectionUnit: "M",
+			WindSpeedKnots:        10,
+			WindSpeedKnotsUnit:    "N",
+			WindSpeedMeters:       5.1,
+			WindSpeedMetersUnit:   "M",
+		},
+	},
+	{
+		name: "invalid wind speed",
+		raw:  "$WIMWD,290.0,T,280.0,M,x,N,5.1,M*09",
+		err:  "nmea: WIMWD invalid wind speed (knots): x",
+	},
+}
+
+func TestMWD(t *testing.T) {
+	for _, tt := range mwdtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				mwd := m.(MWD)
+				mwd.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, mwd)
+			}
+		})
+	}
+}
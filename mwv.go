@@ -0,0 +1,64 @@
+package nmea
+
+const (
+	// TypeMWV type for MWV sentences
+	TypeMWV = "MWV"
+	// RelativeMWV relative wind angle
+	RelativeMWV = "R"
+	// TheoreticalMWV theoretical (true) wind angle
+	TheoreticalMWV = "T"
+	// KilometersPerHourMWV wind speed unit
+	KilometersPerHourMWV = "K"
+	// MetersPerSecondMWV wind speed unit
+	MetersPerSecondMWV = "M"
+	// KnotsMWV wind speed unit
+	KnotsMWV = "N"
+	// ValidMWV data valid
+	ValidMWV = "A"
+	// InvalidMWV data invalid
+	InvalidMWV = "V"
+)
+
+// MWV is the wind speed and angle.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_mwv_wind_speed_and_angle
+type MWV struct {
+	BaseSentence
+	Angle         float64 // Wind angle in degrees, 0 to 359
+	Reference     string  // Reference, R = relative, T = theoretical
+	WindSpeed     float64 // Wind speed
+	WindSpeedUnit string  // Wind speed unit, K = km/h, M = m/s, N = knots
+	Status        string  // Status, A = data valid, V = invalid
+}
+
+func (s MWV) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"angle":           s.Angle,
+		"reference":       s.Reference,
+		"wind_speed":      s.WindSpeed,
+		"wind_speed_unit": s.WindSpeedUnit,
+		"status":          s.Status,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newMWV constructor
+func newMWV(s BaseSentence) (MWV, error) {
+	p := NewParser(s)
+	p.AssertType(TypeMWV)
+	m := MWV{
+		BaseSentence:  s,
+		Angle:         p.Float64(0, "angle"),
+		Reference:     p.EnumString(1, "reference", RelativeMWV, TheoreticalMWV),
+		WindSpeed:     p.Float64(2, "wind speed"),
+		WindSpeedUnit: p.EnumString(3, "wind speed unit", KilometersPerHourMWV, MetersPerSecondMWV, KnotsMWV),
+		Status:        p.EnumString(4, "status", ValidMWV, InvalidMWV),
+	}
+	return m, p.Err()
+}
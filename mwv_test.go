@@ -0,0 +1,69 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mwvtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  MWV
+}{
+	{
+		name: "good sentence",
+		raw:  "$WIMWV,214.8,R,0.1,K,A*28",
+		msg: MWV{
+			Angle:         214.8,
+			Reference:     RelativeMWV,
+			WindSpeed:     0.1,
+			WindSpeedUnit: KilometersPerHourMWV,
+			Status:        ValidMWV,
+		},
+	},
+	{
+		name: "good sentence invalid data",
+		raw:  "$WIMWV,214.8,T,12.5,N,V*0B",
+		msg: MWV{
+			Angle:         214.8,
+			Reference:     TheoreticalMWV,
+			WindSpeed:     12.5,
+			WindSpeedUnit: KnotsMWV,
+			Status:        InvalidMWV,
+		},
+	},
+	{
+		name: "bad reference",
+		raw:  "$WIMWV,214.8,X,0.1,K,A*22",
+		err:  "nmea: WIMWV invalid reference: X",
+	},
+	{
+		name: "bad wind speed unit",
+		raw:  "$WIMWV,214.8,T,0.1,Z,A*3F",
+		err:  "nmea: WIMWV invalid wind speed unit: Z",
+	},
+	{
+		name: "bad status",
+		raw:  "$WIMWV,214.8,T,12.5,N,X*05",
+		err:  "nmea: WIMWV invalid status: X",
+	},
+}
+
+func TestMWV(t *testing.T) {
+	for _, tt := range mwvtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				mwv := m.(MWV)
+				mwv.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, mwv)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package nmea
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// ParseStats summarizes the sentences read by ParseAll.
+type ParseStats struct {
+	// Counts holds the number of sentences of each type, ignored ones included.
+	// The type of proprietary sentences includes their manufacturer mnemonic (e.g. GRME).
+	Counts map[string]int
+	// Errors is the number of sentence lines that failed to parse.
+	Errors int
+}
+
+// ParseAll parses every sentence line read from r, skipping lines the same
+// way as Scanner. Sentences of the ignored types (e.g. TypeGSV) are only
+// counted and are not decoded, which speeds up analyzing feeds where most
+// of the traffic is uninteresting. Lines that fail to parse are counted in
+// the stats and do not stop the parse; the returned error is that of the
+// underlying reader.
+func ParseAll(r io.Reader, ignore ...string) ([]Sentence, ParseStats, error) {
+	skip := make(map[string]bool, len(ignore))
+	for _, typ := range ignore {
+		skip[typ] = true
+	}
+	stats := ParseStats{Counts: map[string]int{}}
+	var sentences []Sentence
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		raw, ok := sentenceLine(scanner.Text())
+		if !ok {
+			continue
+		}
+		if key, ok := peekKey(raw); ok && skip[key] {
+			stats.Counts[key]++
+			continue
+		}
+		s, err := Parse(raw)
+		if err != nil {
+			stats.Errors++
+			continue
+		}
+		key := s.DataType()
+		if b, ok := s.(interface{ baseSentence() BaseSentence }); ok {
+			key = b.baseSentence().key()
+		}
+		stats.Counts[key]++
+		sentences = append(sentences, s)
+	}
+	return sentences, stats, scanner.Err()
+}
+
+// peekKey returns the type of the raw sentence as used for dispatch,
+// skipping its TAG block, without validating the sentence.
+func peekKey(raw string) (string, bool) {
+	if strings.HasPrefix(raw, TagBlockSep) {
+		end := strings.Index(raw[1:], TagBlockSep)
+		if end < 0 {
+			return "", false
+		}
+		raw = raw[end+2:]
+	}
+	end := strings.IndexAny(raw, FieldSep+ChecksumSep)
+	if len(raw) == 0 || end <= 1 {
+		return "", false
+	}
+	talker, typ, proprietary := parsePrefix(raw[1:end])
+	s := BaseSentence{Talker: talker, Type: typ, Proprietary: proprietary}
+	return s.key(), true
+}
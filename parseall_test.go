@@ -0,0 +1,42 @@
+package nmea
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAll(t *testing.T) {
+	input := strings.Join([]string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GPGSV,3,1,11,03,03,111,00,04,15,270,00,06,01,010,00,13,06,292,00*74",
+		"$GPGSV,3,1,11,03,03,111,00,04,15,270,00,06,01,010,00,13,06,292,XX*74",
+		"not a sentence",
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*52",
+		"$INTHS,123.456,A*20",
+	}, "\r\n")
+
+	sentences, stats, err := ParseAll(strings.NewReader(input))
+	assert.NoError(t, err)
+	assert.Len(t, sentences, 3)
+	assert.Equal(t, map[string]int{TypeGGA: 1, TypeGSV: 1, TypeTHS: 1}, stats.Counts)
+	assert.Equal(t, 2, stats.Errors)
+}
+
+func TestParseAllIgnore(t *testing.T) {
+	input := strings.Join([]string{
+		"$GPGSV,3,1,11,03,03,111,00,04,15,270,00,06,01,010,00,13,06,292,00*74",
+		"$GPGSV,3,1,11,03,03,111,00,04,15,270,00,06,01,010,00,13,06,292,XX*74",
+		"$INTHS,123.456,A*20",
+	}, "\n")
+
+	sentences, stats, err := ParseAll(strings.NewReader(input), TypeGSV)
+	assert.NoError(t, err)
+	if assert.Len(t, sentences, 1) {
+		assert.Equal(t, TypeTHS, sentences[0].DataType())
+	}
+	// the malformed GSV is not decoded, so it is not an error
+	assert.Equal(t, map[string]int{TypeGSV: 2, TypeTHS: 1}, stats.Counts)
+	assert.Equal(t, 0, stats.Errors)
+}
@@ -3,13 +3,19 @@ package nmea
 import (
 	"fmt"
 	"strconv"
+	"strings"
 )
 
 // Parser provides a simple way of accessing and parsing
 // sentence fields
 type Parser struct {
 	BaseSentence
-	err error
+	// ContinueOnError makes the parser keep parsing fields after the
+	// first error so AllErrors can report every failure at once.
+	// Err still returns the first error.
+	ContinueOnError bool
+	err             error
+	errs            []error
 }
 
 // NewParser constructor
@@ -18,9 +24,10 @@ func NewParser(s BaseSentence) *Parser {
 }
 
 // AssertType makes sure the sentence's type matches the provided one.
+// The type of proprietary sentences includes their manufacturer mnemonic (e.g. GRME).
 func (p *Parser) AssertType(typ string) {
-	if p.Type != typ {
-		p.SetErr("type", p.Type)
+	if p.key() != typ {
+		p.SetErr("type", p.key())
 	}
 }
 
@@ -29,30 +36,53 @@ func (p *Parser) Err() error {
 	return p.err
 }
 
-// SetErr assigns an error. Calling this method has no
-// effect if there is already an error.
+// AllErrors returns every error encountered during the parser's usage.
+// Unless ContinueOnError is set, parsing stops at the first error.
+func (p *Parser) AllErrors() []error {
+	return p.errs
+}
+
+// SetErr assigns an error. Calling this method has no effect if
+// there is already an error, unless ContinueOnError is set.
 func (p *Parser) SetErr(context, value string) {
+	if p.err != nil && !p.ContinueOnError {
+		return
+	}
+	err := fmt.Errorf("nmea: %s invalid %s: %s", p.Prefix(), context, value)
 	if p.err == nil {
-		p.err = fmt.Errorf("nmea: %s invalid %s: %s", p.Prefix(), context, value)
+		p.err = err
 	}
+	p.errs = append(p.errs, err)
 }
 
-// String returns the field value at the specified index.
-func (p *Parser) String(i int, context string) string {
-	if p.err != nil {
-		return ""
+// stopped reports whether parsing should stop because of a previous error.
+func (p *Parser) stopped() bool {
+	return p.err != nil && !p.ContinueOnError
+}
+
+// field returns the field value at the specified index.
+// ok is false if parsing stopped or the index is out of range.
+func (p *Parser) field(i int, context string) (s string, ok bool) {
+	if p.stopped() {
+		return "", false
 	}
 	if i < 0 || i >= len(p.Fields) {
 		p.SetErr(context, "index out of range")
-		return ""
+		return "", false
 	}
-	return p.Fields[i]
+	return p.Fields[i], true
+}
+
+// String returns the field value at the specified index.
+func (p *Parser) String(i int, context string) string {
+	s, _ := p.field(i, context)
+	return s
 }
 
 // ListString returns a list of all fields from the given start index.
 // An error occurs if there is no fields after the given start index.
 func (p *Parser) ListString(from int, context string) (list []string) {
-	if p.err != nil {
+	if p.stopped() {
 		return []string{}
 	}
 	if from < 0 || from >= len(p.Fields) {
@@ -62,11 +92,27 @@ func (p *Parser) ListString(from int, context string) (list []string) {
 	return append(list, p.Fields[from:]...)
 }
 
+// Slice returns length characters of the field at the specified index,
+// starting at the given offset. It is used for proprietary sentences that
+// pack several fixed-width values into a single field.
+// An error occurs if the field is shorter than start+length.
+func (p *Parser) Slice(i, start, length int, context string) string {
+	s, ok := p.field(i, context)
+	if !ok {
+		return ""
+	}
+	if start < 0 || length < 0 || start+length > len(s) {
+		p.SetErr(context, s)
+		return ""
+	}
+	return s[start : start+length]
+}
+
 // EnumString returns the field value at the specified index.
 // An error occurs if the value is not one of the options and not empty.
 func (p *Parser) EnumString(i int, context string, options ...string) string {
-	s := p.String(i, context)
-	if p.err != nil || s == "" {
+	s, ok := p.field(i, context)
+	if !ok || s == "" {
 		return ""
 	}
 	for _, o := range options {
@@ -82,8 +128,8 @@ func (p *Parser) EnumString(i int, context string, options ...string) string {
 // It will only match the number of characters that are in the Mode field.
 // If the value is empty, it will return an empty array
 func (p *Parser) EnumChars(i int, context string, options ...string) []string {
-	s := p.String(i, context)
-	if p.err != nil || s == "" {
+	s, ok := p.field(i, context)
+	if !ok || s == "" {
 		return []string{}
 	}
 	strs := []string{}
@@ -107,11 +153,8 @@ func (p *Parser) EnumChars(i int, context string, options ...string) []string {
 // Int64 returns the int64 value at the specified index.
 // If the value is an empty string, 0 is returned.
 func (p *Parser) Int64(i int, context string) int64 {
-	s := p.String(i, context)
-	if p.err != nil {
-		return 0
-	}
-	if s == "" {
+	s, ok := p.field(i, context)
+	if !ok || s == "" {
 		return 0
 	}
 	v, err := strconv.ParseInt(s, 10, 64)
@@ -121,17 +164,53 @@ func (p *Parser) Int64(i int, context string) int64 {
 	return v
 }
 
+// HexInt64 returns the int64 value of the hexadecimal field at the specified
+// index, with or without a "0x" prefix.
+// If the value is an empty string, 0 is returned.
+func (p *Parser) HexInt64(i int, context string) int64 {
+	s, ok := p.field(i, context)
+	if !ok || s == "" {
+		return 0
+	}
+	h := s
+	if strings.HasPrefix(h, "0x") || strings.HasPrefix(h, "0X") {
+		h = h[2:]
+	}
+	v, err := strconv.ParseInt(h, 16, 64)
+	if err != nil || strings.HasPrefix(h, "-") || strings.HasPrefix(h, "+") {
+		p.SetErr(context, s)
+		return 0
+	}
+	return v
+}
+
 // Float64 returns the float64 value at the specified index.
 // If the value is an empty string, 0 is returned.
 func (p *Parser) Float64(i int, context string) float64 {
-	s := p.String(i, context)
-	if p.err != nil {
+	s, ok := p.field(i, context)
+	if !ok || s == "" {
 		return 0
 	}
-	if s == "" {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		p.SetErr(context, s)
+	}
+	return v
+}
+
+// Float64Loose is like Float64 but ignores trailing non-numeric characters,
+// such as the unit some devices append to the value (e.g. 12.3M).
+// Sentences are parsed with the strict Float64; this is meant for
+// parsers of devices known to send such values.
+func (p *Parser) Float64Loose(i int, context string) float64 {
+	s, ok := p.field(i, context)
+	if !ok || s == "" {
 		return 0
 	}
-	v, err := strconv.ParseFloat(s, 64)
+	trimmed := strings.TrimRightFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	v, err := strconv.ParseFloat(trimmed, 64)
 	if err != nil {
 		p.SetErr(context, s)
 	}
@@ -141,8 +220,8 @@ func (p *Parser) Float64(i int, context string) float64 {
 // Time returns the Time value at the specified index.
 // If the value is empty, the Time is marked as invalid.
 func (p *Parser) Time(i int, context string) Time {
-	s := p.String(i, context)
-	if p.err != nil {
+	s, ok := p.field(i, context)
+	if !ok {
 		return Time{}
 	}
 	v, err := ParseTime(s)
@@ -155,8 +234,8 @@ func (p *Parser) Time(i int, context string) Time {
 // Date returns the Date value at the specified index.
 // If the value is empty, the Date is marked as invalid.
 func (p *Parser) Date(i int, context string) Date {
-	s := p.String(i, context)
-	if p.err != nil {
+	s, ok := p.field(i, context)
+	if !ok {
 		return Date{}
 	}
 	v, err := ParseDate(s)
@@ -166,11 +245,13 @@ func (p *Parser) Date(i int, context string) Date {
 	return v
 }
 
-// LatLong returns the coordinate value of the specified fields.
+// LatLong returns the coordinate value of the specified fields, the position
+// at index i (e.g. ddmm.mmmm) and the hemisphere at index j, in signed decimal
+// degrees. Empty fields, as sent by receivers without a fix, yield 0.
 func (p *Parser) LatLong(i, j int, context string) float64 {
-	a := p.String(i, context)
-	b := p.String(j, context)
-	if p.err != nil {
+	a, okA := p.field(i, context)
+	b, okB := p.field(j, context)
+	if !okA || !okB {
 		return 0
 	}
 	s := fmt.Sprintf("%s %s", a, b)
@@ -181,9 +262,47 @@ func (p *Parser) LatLong(i, j int, context string) float64 {
 	return v
 }
 
+// Latitude returns the latitude of the specified position and hemisphere fields.
+// An empty field or a hemisphere other than N or S is an error.
+func (p *Parser) Latitude(i, j int, context string) Latitude {
+	a, okA := p.field(i, context)
+	b, okB := p.field(j, context)
+	if !okA || !okB {
+		return 0
+	}
+	if a == "" || b == "" {
+		p.SetErr(context, "empty field")
+		return 0
+	}
+	if b != North && b != South {
+		p.SetErr(context, fmt.Sprintf("%s [%s]", errInvalidHemisphere, b))
+		return 0
+	}
+	return Latitude(p.LatLong(i, j, context))
+}
+
+// Longitude returns the longitude of the specified position and hemisphere fields.
+// An empty field or a hemisphere other than E or W is an error.
+func (p *Parser) Longitude(i, j int, context string) Longitude {
+	a, okA := p.field(i, context)
+	b, okB := p.field(j, context)
+	if !okA || !okB {
+		return 0
+	}
+	if a == "" || b == "" {
+		p.SetErr(context, "empty field")
+		return 0
+	}
+	if b != East && b != West {
+		p.SetErr(context, fmt.Sprintf("%s [%s]", errInvalidHemisphere, b))
+		return 0
+	}
+	return Longitude(p.LatLong(i, j, context))
+}
+
 // SixBitASCIIArmour decodes the 6-bit ascii armor used for VDM and VDO messages
 func (p *Parser) SixBitASCIIArmour(i int, fillBits int, context string) []byte {
-	if p.err != nil {
+	if p.stopped() {
 		return nil
 	}
 	if fillBits < 0 || fillBits >= 6 {
@@ -203,16 +322,12 @@ func (p *Parser) SixBitASCIIArmour(i int, fillBits int, context string) []byte {
 	resultIndex := 0
 
 	for _, v := range payload {
-		if v < 48 || v >= 120 {
+		d, ok := sixBitValue(v)
+		if !ok {
 			p.SetErr(context, "data byte")
 			return nil
 		}
 
-		d := v - 48
-		if d > 40 {
-			d -= 8
-		}
-
 		for i := 5; i >= 0 && resultIndex < len(result); i-- {
 			result[resultIndex] = (d >> uint(i)) & 1
 			resultIndex++
@@ -221,3 +336,15 @@ func (p *Parser) SixBitASCIIArmour(i int, fillBits int, context string) []byte {
 
 	return result
 }
+
+// sixBitValue returns the 6-bit value of a character of the AIS payload armor.
+// ok is false if the character is outside the armor ranges '0'-'W' and '`'-'w'.
+func sixBitValue(c byte) (v byte, ok bool) {
+	switch {
+	case c >= '0' && c <= 'W':
+		return c - '0', true
+	case c >= '`' && c <= 'w':
+		return c - '0' - 8, true
+	}
+	return 0, false
+}
@@ -65,6 +65,50 @@ var parsertests = []struct {
 			return p.String(123, "blah")
 		},
 	},
+	{
+		name:     "Slice",
+		fields:   []string{"wot", "A1234"},
+		expected: "12",
+		parse: func(p *Parser) interface{} {
+			return p.Slice(1, 1, 2, "thing")
+		},
+	},
+	{
+		name:     "Slice to end of field",
+		fields:   []string{"A1234"},
+		expected: "34",
+		parse: func(p *Parser) interface{} {
+			return p.Slice(0, 3, 2, "thing")
+		},
+	},
+	{
+		name:     "Slice out of range",
+		fields:   []string{"A1234"},
+		expected: "",
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			return p.Slice(0, 3, 3, "thing")
+		},
+	},
+	{
+		name:     "Slice negative start",
+		fields:   []string{"A1234"},
+		expected: "",
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			return p.Slice(0, -1, 2, "thing")
+		},
+	},
+	{
+		name:     "Slice with existing error",
+		fields:   []string{"A1234"},
+		expected: "",
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			p.SetErr("context", "value")
+			return p.Slice(0, 0, 1, "thing")
+		},
+	},
 	{
 		name:     "EnumString",
 		fields:   []string{"a", "b", "c"},
@@ -154,6 +198,67 @@ var parsertests = []struct {
 			return p.Int64(0, "context")
 		},
 	},
+	{
+		name:     "HexInt64",
+		fields:   []string{"1F"},
+		expected: int64(31),
+		parse: func(p *Parser) interface{} {
+			return p.HexInt64(0, "context")
+		},
+	},
+	{
+		name:     "HexInt64 with prefix",
+		fields:   []string{"0x1f"},
+		expected: int64(31),
+		parse: func(p *Parser) interface{} {
+			return p.HexInt64(0, "context")
+		},
+	},
+	{
+		name:     "HexInt64 empty field is zero",
+		fields:   []string{""},
+		expected: int64(0),
+		parse: func(p *Parser) interface{} {
+			return p.HexInt64(0, "context")
+		},
+	},
+	{
+		name:     "HexInt64 invalid",
+		fields:   []string{"0xG1"},
+		expected: int64(0),
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			return p.HexInt64(0, "context")
+		},
+	},
+	{
+		name:     "HexInt64 prefix only",
+		fields:   []string{"0x"},
+		expected: int64(0),
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			return p.HexInt64(0, "context")
+		},
+	},
+	{
+		name:     "HexInt64 signed",
+		fields:   []string{"-1"},
+		expected: int64(0),
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			return p.HexInt64(0, "context")
+		},
+	},
+	{
+		name:     "HexInt64 with existing error",
+		fields:   []string{"1F"},
+		expected: int64(0),
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			p.SetErr("context", "value")
+			return p.HexInt64(0, "context")
+		},
+	},
 	{
 		name:     "Float64",
 		fields:   []string{"123.123"},
@@ -189,6 +294,40 @@ var parsertests = []struct {
 			return p.Float64(0, "context")
 		},
 	},
+	{
+		name:     "Float64Loose",
+		fields:   []string{"12.3"},
+		expected: float64(12.3),
+		parse: func(p *Parser) interface{} {
+			return p.Float64Loose(0, "context")
+		},
+	},
+	{
+		name:     "Float64Loose trailing unit",
+		fields:   []string{"12.3M"},
+		expected: float64(12.3),
+		parse: func(p *Parser) interface{} {
+			return p.Float64Loose(0, "context")
+		},
+	},
+	{
+		name:     "Float64Loose unit only",
+		fields:   []string{"M"},
+		expected: float64(0),
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			return p.Float64Loose(0, "context")
+		},
+	},
+	{
+		name:     "Float64 trailing unit",
+		fields:   []string{"12.3M"},
+		expected: float64(0),
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			return p.Float64(0, "context")
+		},
+	},
 	{
 		name:     "Time",
 		fields:   []string{"123456"},
@@ -249,6 +388,15 @@ var parsertests = []struct {
 			return p.Date(0, "context")
 		},
 	},
+	{
+		name:     "Date out of range",
+		fields:   []string{"321303"},
+		expected: Date{},
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			return p.Date(0, "context")
+		},
+	},
 	{
 		name:     "Date with existing error",
 		fields:   []string{"010203"},
@@ -259,6 +407,67 @@ var parsertests = []struct {
 			return p.Date(0, "context")
 		},
 	},
+	{
+		name:     "Latitude",
+		fields:   []string{"4807.5", "S"},
+		expected: Latitude(-48.125),
+		parse: func(p *Parser) interface{} {
+			return p.Latitude(0, 1, "latitude")
+		},
+	},
+	{
+		name:     "Latitude empty fields",
+		fields:   []string{"", ""},
+		expected: Latitude(0),
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			return p.Latitude(0, 1, "latitude")
+		},
+	},
+	{
+		name:     "Latitude empty hemisphere",
+		fields:   []string{"4807.5", ""},
+		expected: Latitude(0),
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			return p.Latitude(0, 1, "latitude")
+		},
+	},
+	{
+		name:     "Latitude wrong hemisphere",
+		fields:   []string{"4807.5", "E"},
+		expected: Latitude(0),
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			return p.Latitude(0, 1, "latitude")
+		},
+	},
+	{
+		name:     "Longitude",
+		fields:   []string{"01130.0", "W"},
+		expected: Longitude(-11.5),
+		parse: func(p *Parser) interface{} {
+			return p.Longitude(0, 1, "longitude")
+		},
+	},
+	{
+		name:     "Longitude empty value",
+		fields:   []string{"", "W"},
+		expected: Longitude(0),
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			return p.Longitude(0, 1, "longitude")
+		},
+	},
+	{
+		name:     "Longitude wrong hemisphere",
+		fields:   []string{"01130.0", "N"},
+		expected: Longitude(0),
+		hasErr:   true,
+		parse: func(p *Parser) interface{} {
+			return p.Longitude(0, 1, "longitude")
+		},
+	},
 }
 
 func TestParser(t *testing.T) {
@@ -278,3 +487,38 @@ func TestParser(t *testing.T) {
 		})
 	}
 }
+
+func TestParserAllErrors(t *testing.T) {
+	s := BaseSentence{
+		Talker: "GP",
+		Type:   "GGA",
+		Fields: []string{"034225.077", "X", "S", "15124.5567", "E", "1", "0A", "9.7", "-25.0", "M", "2B.0", "M", "", "0000"},
+	}
+
+	p := NewParser(s)
+	p.ContinueOnError = true
+	p.Time(0, "time")
+	p.LatLong(1, 2, "latitude")
+	p.LatLong(3, 4, "longitude")
+	p.Int64(6, "number of satellites")
+	p.Float64(7, "hdop")
+	p.Float64(10, "separation")
+	assert.EqualError(t, p.Err(), "nmea: GPGGA invalid latitude: cannot parse [X S], unknown format")
+	errs := []string{}
+	for _, err := range p.AllErrors() {
+		errs = append(errs, err.Error())
+	}
+	assert.Equal(t, []string{
+		"nmea: GPGGA invalid latitude: cannot parse [X S], unknown format",
+		"nmea: GPGGA invalid number of satellites: 0A",
+		"nmea: GPGGA invalid separation: 2B.0",
+	}, errs)
+
+	// without ContinueOnError parsing stops at the first error
+	p = NewParser(s)
+	p.LatLong(1, 2, "latitude")
+	p.Int64(6, "number of satellites")
+	p.Float64(10, "separation")
+	assert.Len(t, p.AllErrors(), 1)
+	assert.Equal(t, p.Err(), p.AllErrors()[0])
+}
@@ -0,0 +1,49 @@
+package nmea
+
+const (
+	// TypePGRMZ type for PGRMZ sentences
+	TypePGRMZ = "GRMZ"
+	// FeetPGRMZ is the altitude unit (feet)
+	FeetPGRMZ = "f"
+	// Fix2DPGRMZ 2D fix
+	Fix2DPGRMZ = 2
+	// Fix3DPGRMZ 3D fix
+	Fix3DPGRMZ = 3
+)
+
+// PGRMZ is Altitude Information (Garmin proprietary sentence)
+// http://aprs.gids.nl/nmea/#rmz
+type PGRMZ struct {
+	BaseSentence
+	Altitude float64 // Current altitude
+	Unit     string  // Altitude unit, f = feet
+	FixType  int64   // Position fix dimension, 2 = 2D, 3 = 3D
+}
+
+func (s PGRMZ) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"altitude": s.Altitude,
+		"unit":     s.Unit,
+		"fix_type": s.FixType,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newPGRMZ constructor
+func newPGRMZ(s BaseSentence) (PGRMZ, error) {
+	p := NewParser(s)
+	p.AssertType(TypePGRMZ)
+	return PGRMZ{
+		BaseSentence: s,
+		Altitude:     p.Float64(0, "altitude"),
+		Unit:         p.EnumString(1, "unit", FeetPGRMZ),
+		FixType:      p.Int64(2, "fix type"),
+	}, p.Err()
+}
@@ -0,0 +1,60 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pgrmztests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PGRMZ
+}{
+	{
+		name: "good sentence",
+		raw:  "$PGRMZ,246,f,3*1B",
+		msg: PGRMZ{
+			Altitude: 246,
+			Unit:     FeetPGRMZ,
+			FixType:  Fix3DPGRMZ,
+		},
+	},
+	{
+		name: "good sentence 2D fix",
+		raw:  "$PGRMZ,93,f,2*20",
+		msg: PGRMZ{
+			Altitude: 93,
+			Unit:     FeetPGRMZ,
+			FixType:  Fix2DPGRMZ,
+		},
+	},
+	{
+		name: "invalid altitude",
+		raw:  "$PGRMZ,A,f,3*6A",
+		err:  "nmea: PGRMZ invalid altitude: A",
+	},
+	{
+		name: "invalid unit",
+		raw:  "$PGRMZ,246,M,3*30",
+		err:  "nmea: PGRMZ invalid unit: M",
+	},
+}
+
+func TestPGRMZ(t *testing.T) {
+	for _, tt := range pgrmztests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pgrmz := m.(PGRMZ)
+				pgrmz.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pgrmz)
+			}
+		})
+	}
+}
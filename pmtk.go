@@ -0,0 +1,94 @@
+package nmea
+
+import "strconv"
+
+const (
+	// TypePMTK manufacturer mnemonic of PMTK sentences
+	TypePMTK = "MTK"
+	// TypePMTK001 type for PMTK001 (acknowledge) sentences
+	TypePMTK001 = "MTK001"
+	// InvalidPMTK001 invalid command or packet
+	InvalidPMTK001 = 0
+	// UnsupportedPMTK001 unsupported command or packet type
+	UnsupportedPMTK001 = 1
+	// FailedPMTK001 valid command, but action failed
+	FailedPMTK001 = 2
+	// SucceededPMTK001 valid command, and action succeeded
+	SucceededPMTK001 = 3
+)
+
+// PMTK001 is the acknowledge of a PMTK command (MediaTek proprietary sentence)
+type PMTK001 struct {
+	BaseSentence
+	Command int64 // Command number being acknowledged
+	Flag    int64 // Result of the command
+}
+
+func (s PMTK001) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"command": s.Command,
+		"flag":    s.Flag,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newPMTK001 constructor
+func newPMTK001(s BaseSentence) (PMTK001, error) {
+	p := NewParser(s)
+	p.AssertType(TypePMTK001)
+	return PMTK001{
+		BaseSentence: s,
+		Command:      p.Int64(0, "command"),
+		Flag:         p.Int64(1, "flag"),
+	}, p.Err()
+}
+
+// PMTK is a generic PMTK command (MediaTek proprietary sentence)
+// for packet types without a dedicated struct.
+type PMTK struct {
+	BaseSentence
+	Command int64    // Packet type taken from the sentence address (e.g. 220 for PMTK220)
+	Data    []string // Remaining fields
+}
+
+func (s PMTK) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"command": s.Command,
+		"data":    s.Data,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newPMTK constructor
+func newPMTK(s BaseSentence) (PMTK, error) {
+	p := NewParser(s)
+	if !s.Proprietary || s.Talker != TypePMTK {
+		p.SetErr("type", s.key())
+	}
+	m := PMTK{
+		BaseSentence: s,
+		Data:         s.Fields,
+	}
+	if p.Err() == nil {
+		command, err := strconv.ParseInt(s.Type, 10, 64)
+		if err != nil {
+			p.SetErr("command", s.key())
+		}
+		m.Command = command
+	}
+	return m, p.Err()
+}
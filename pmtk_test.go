@@ -0,0 +1,83 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pmtk001tests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PMTK001
+}{
+	{
+		name: "good sentence",
+		raw:  "$PMTK001,604,3*32",
+		msg: PMTK001{
+			Command: 604,
+			Flag:    SucceededPMTK001,
+		},
+	},
+	{
+		name: "invalid command",
+		raw:  "$PMTK001,A,3*41",
+		err:  "nmea: PMTK001 invalid command: A",
+	},
+}
+
+func TestPMTK001(t *testing.T) {
+	for _, tt := range pmtk001tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pmtk := m.(PMTK001)
+				pmtk.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pmtk)
+			}
+		})
+	}
+}
+
+var pmtktests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PMTK
+}{
+	{
+		name: "good sentence",
+		raw:  "$PMTK220,1000*1F",
+		msg: PMTK{
+			Command: 220,
+			Data:    []string{"1000"},
+		},
+	},
+	{
+		name: "invalid command",
+		raw:  "$PMTKXYZ,1*44",
+		err:  "nmea: PMTKXYZ invalid command: MTKXYZ",
+	},
+}
+
+func TestPMTK(t *testing.T) {
+	for _, tt := range pmtktests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pmtk := m.(PMTK)
+				pmtk.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pmtk)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package nmea
+
+import "math"
+
+const (
+	// earthRadius is the mean radius of the Earth in meters.
+	earthRadius = 6371008.8
+	// metersPerNauticalMile is the length of a nautical mile in meters.
+	metersPerNauticalMile = 1852
+)
+
+// Position source rankings used by BestPosition, highest quality first.
+const (
+	rankRTK = 6 - iota
+	rankFloatRTK
+	rankDGPS
+	rankGPS
+	rankRMC
+	rankGLL
+	rankNone
+)
+
+// BestPosition returns the position of the highest quality source among the
+// sentences of a single epoch. GGA and GNS fixes are ranked by their fix
+// quality (RTK > float RTK > DGPS > GPS), followed by valid RMC and GLL
+// sentences. source is the data type of the chosen sentence, and ok is
+// false if none of the sentences carries a usable position.
+func BestPosition(sentences []Sentence) (lat, lon float64, source string, ok bool) {
+	best := rankNone
+	for _, s := range sentences {
+		rank, la, lo := positionRank(s)
+		if rank > best {
+			best, lat, lon, source = rank, la, lo, s.DataType()
+		}
+	}
+	return lat, lon, source, best != rankNone
+}
+
+// positionRank returns the quality rank and position of a sentence.
+func positionRank(s Sentence) (int, float64, float64) {
+	switch m := s.(type) {
+	case GGA:
+		switch m.FixQuality {
+		case RTK:
+			return rankRTK, m.Latitude, m.Longitude
+		case FRTK:
+			return rankFloatRTK, m.Latitude, m.Longitude
+		case DGPS, PPS:
+			return rankDGPS, m.Latitude, m.Longitude
+		case GPS:
+			return rankGPS, m.Latitude, m.Longitude
+		}
+	case GNS:
+		rank := rankNone
+		for _, mode := range m.Mode {
+			r := rankNone
+			switch mode {
+			case RealTimeKinematicGNS:
+				r = rankRTK
+			case FloatRTKGNS:
+				r = rankFloatRTK
+			case DifferentialGNS, PreciseGNS:
+				r = rankDGPS
+			case AutonomousGNS:
+				r = rankGPS
+			}
+			if r > rank {
+				rank = r
+			}
+		}
+		return rank, m.Latitude, m.Longitude
+	case RMC:
+		if m.Validity == ValidRMC {
+			return rankRMC, m.Latitude, m.Longitude
+		}
+	case GLL:
+		if m.Validity != InvalidGLL {
+			return rankGLL, m.Latitude, m.Longitude
+		}
+	}
+	return rankNone, 0, 0
+}
+
+// DerivedMotion returns the speed over ground in knots and the true course
+// in degrees between two successive positions, for receivers that only send
+// GGA. The distance is the haversine great circle distance and the course the
+// initial bearing from the first position to the second. A second time earlier
+// than the first is taken to be on the next day. Both values are 0 if either
+// time is invalid or no time elapsed.
+func DerivedMotion(lat1, lon1 float64, t1 Time, lat2, lon2 float64, t2 Time) (speedKnots, courseDeg float64) {
+	if !t1.Valid || !t2.Valid {
+		return 0, 0
+	}
+	elapsed := t2.milliseconds() - t1.milliseconds()
+	if elapsed < 0 {
+		elapsed += 24 * 60 * 60 * 1000
+	}
+	if elapsed == 0 {
+		return 0, 0
+	}
+	phi1, phi2 := lat1*math.Pi/180, lat2*math.Pi/180
+	dPhi, dLambda := phi2-phi1, (lon2-lon1)*math.Pi/180
+
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	distance := 2 * earthRadius * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+	speedKnots = distance / metersPerNauticalMile / (float64(elapsed) / 3600000)
+
+	y := math.Sin(dLambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(dLambda)
+	courseDeg = math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+	return speedKnots, courseDeg
+}
+
+// Position returns the position of the fix as typed coordinates.
+func (s GGA) Position() (Latitude, Longitude) {
+	return Latitude(s.Latitude), Longitude(s.Longitude)
+}
+
+// Position returns the position of the fix as typed coordinates.
+func (s GLL) Position() (Latitude, Longitude) {
+	return Latitude(s.Latitude), Longitude(s.Longitude)
+}
+
+// Position returns the position of the fix as typed coordinates.
+func (s GNS) Position() (Latitude, Longitude) {
+	return Latitude(s.Latitude), Longitude(s.Longitude)
+}
+
+// Position returns the position of the fix as typed coordinates.
+func (s RMC) Position() (Latitude, Longitude) {
+	return Latitude(s.Latitude), Longitude(s.Longitude)
+}
+
+// Position returns the position of the fix as typed coordinates.
+func (s PUBX00) Position() (Latitude, Longitude) {
+	return Latitude(s.Latitude), Longitude(s.Longitude)
+}
+
+// Position returns the position of the waypoint as typed coordinates.
+func (s WPL) Position() (Latitude, Longitude) {
+	return Latitude(s.Latitude), Longitude(s.Longitude)
+}
+
+// Position returns the position of the destination waypoint as typed coordinates.
+func (s RMB) Position() (Latitude, Longitude) {
+	return Latitude(s.Latitude), Longitude(s.Longitude)
+}
+
+// Position returns the position of the waypoint as typed coordinates.
+func (s BWC) Position() (Latitude, Longitude) {
+	return Latitude(s.Latitude), Longitude(s.Longitude)
+}
@@ -0,0 +1,112 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBestPosition(t *testing.T) {
+	var (
+		ggaRTK   = GGA{BaseSentence: BaseSentence{Type: TypeGGA}, FixQuality: RTK, Latitude: 1, Longitude: 1}
+		ggaDGPS  = GGA{BaseSentence: BaseSentence{Type: TypeGGA}, FixQuality: DGPS, Latitude: 2, Longitude: 2}
+		ggaGPS   = GGA{BaseSentence: BaseSentence{Type: TypeGGA}, FixQuality: GPS, Latitude: 3, Longitude: 3}
+		ggaNoFix = GGA{BaseSentence: BaseSentence{Type: TypeGGA}, FixQuality: Invalid, Latitude: 4, Longitude: 4}
+		gnsRTK   = GNS{BaseSentence: BaseSentence{Type: TypeGNS}, Mode: []string{NoFixGNS, RealTimeKinematicGNS}, Latitude: 5, Longitude: 5}
+		gnsFloat = GNS{BaseSentence: BaseSentence{Type: TypeGNS}, Mode: []string{FloatRTKGNS}, Latitude: 6, Longitude: 6}
+		rmc      = RMC{BaseSentence: BaseSentence{Type: TypeRMC}, Validity: ValidRMC, Latitude: 7, Longitude: 7}
+		rmcBad   = RMC{BaseSentence: BaseSentence{Type: TypeRMC}, Validity: InvalidRMC, Latitude: 8, Longitude: 8}
+		gll      = GLL{BaseSentence: BaseSentence{Type: TypeGLL}, Validity: ValidGLL, Latitude: 9, Longitude: 9}
+		gllBad   = GLL{BaseSentence: BaseSentence{Type: TypeGLL}, Validity: InvalidGLL, Latitude: 10, Longitude: 10}
+	)
+	tests := []struct {
+		name      string
+		sentences []Sentence
+		lat       float64
+		source    string
+		ok        bool
+	}{
+		{"rtk over dgps", []Sentence{gll, ggaDGPS, ggaRTK}, 1, TypeGGA, true},
+		{"gns rtk over gps", []Sentence{ggaGPS, gnsRTK}, 5, TypeGNS, true},
+		{"float rtk over dgps", []Sentence{ggaDGPS, gnsFloat}, 6, TypeGNS, true},
+		{"dgps over gps", []Sentence{ggaGPS, ggaDGPS}, 2, TypeGGA, true},
+		{"gps over rmc", []Sentence{rmc, ggaGPS}, 3, TypeGGA, true},
+		{"rmc over gll", []Sentence{gll, rmc}, 7, TypeRMC, true},
+		{"gll over invalid fixes", []Sentence{ggaNoFix, rmcBad, gll}, 9, TypeGLL, true},
+		{"first of equal quality", []Sentence{ggaGPS, ggaGPS}, 3, TypeGGA, true},
+		{"no usable position", []Sentence{ggaNoFix, rmcBad, gllBad, ZDA{}}, 0, "", false},
+		{"no sentences", nil, 0, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lat, lon, source, ok := BestPosition(tt.sentences)
+			assert.Equal(t, tt.lat, lat)
+			assert.Equal(t, tt.lat, lon)
+			assert.Equal(t, tt.source, source)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestDerivedMotion(t *testing.T) {
+	tests := []struct {
+		name       string
+		lat1, lon1 float64
+		t1         Time
+		lat2, lon2 float64
+		t2         Time
+		speed      float64
+		course     float64
+	}{
+		{
+			name: "one arc minute north in a minute",
+			lat1: 48, lon1: 11, t1: Time{true, 12, 0, 0, 0},
+			lat2: 48 + 1.0/60, lon2: 11, t2: Time{true, 12, 1, 0, 0},
+			speed: 60.04, course: 0,
+		},
+		{
+			name: "one arc minute east on the equator in an hour",
+			lat1: 0, lon1: 11, t1: Time{true, 12, 0, 0, 0},
+			lat2: 0, lon2: 11 + 1.0/60, t2: Time{true, 13, 0, 0, 0},
+			speed: 1.0007, course: 90,
+		},
+		{
+			name: "south west across midnight",
+			lat1: 0, lon1: 0, t1: Time{true, 23, 59, 30, 0},
+			lat2: -0.01, lon2: -0.01, t2: Time{true, 0, 0, 30, 0},
+			speed: 50.95, course: 225,
+		},
+		{
+			name: "no elapsed time",
+			lat1: 0, lon1: 0, t1: Time{true, 12, 0, 0, 0},
+			lat2: 1, lon2: 1, t2: Time{true, 12, 0, 0, 0},
+		},
+		{
+			name: "invalid time",
+			lat1: 0, lon1: 0, t1: Time{},
+			lat2: 1, lon2: 1, t2: Time{true, 12, 0, 0, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			speed, course := DerivedMotion(tt.lat1, tt.lon1, tt.t1, tt.lat2, tt.lon2, tt.t2)
+			assert.InDelta(t, tt.speed, speed, 0.01)
+			assert.InDelta(t, tt.course, course, 0.01)
+		})
+	}
+}
+
+func TestPosition(t *testing.T) {
+	m, err := Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51")
+	assert.NoError(t, err)
+	lat, lon := m.(GGA).Position()
+	assert.Equal(t, Latitude(MustParseLatLong("3356.4650 S")), lat)
+	assert.Equal(t, Longitude(MustParseLatLong("15124.5567 E")), lon)
+	assert.Equal(t, m.(GGA).Latitude, float64(lat))
+
+	m, err = Parse("$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V*20")
+	assert.NoError(t, err)
+	lat, lon = m.(RMB).Position()
+	assert.Equal(t, Latitude(MustParseGPS("4917.24 N")), lat)
+	assert.Equal(t, Longitude(MustParseGPS("12309.57 W")), lon)
+}
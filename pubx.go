@@ -0,0 +1,198 @@
+package nmea
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// TypePUBX type for PUBX sentences
+	TypePUBX = "UBX"
+	// PositionPUBX message id of the PUBX,00 position sentence
+	PositionPUBX = "00"
+	// TimePUBX message id of the PUBX,04 time of day and clock sentence
+	TimePUBX = "04"
+	// NoFixPUBX navigation status no fix
+	NoFixPUBX = "NF"
+	// DeadReckoningPUBX navigation status dead reckoning only
+	DeadReckoningPUBX = "DR"
+	// StandAlone2DPUBX navigation status stand alone 2D solution
+	StandAlone2DPUBX = "G2"
+	// StandAlone3DPUBX navigation status stand alone 3D solution
+	StandAlone3DPUBX = "G3"
+	// Differential2DPUBX navigation status differential 2D solution
+	Differential2DPUBX = "D2"
+	// Differential3DPUBX navigation status differential 3D solution
+	Differential3DPUBX = "D3"
+	// CombinedPUBX navigation status combined GPS and dead reckoning solution
+	CombinedPUBX = "RK"
+	// TimeOnlyPUBX navigation status time only solution
+	TimeOnlyPUBX = "TT"
+)
+
+// PUBX00 is the Lat/Long position data (u-blox proprietary sentence)
+// https://www.u-blox.com/sites/default/files/products/documents/u-blox8-M8_ReceiverDescrProtSpec_(UBX-13003221).pdf
+type PUBX00 struct {
+	BaseSentence
+	Time               Time    // UTC time
+	Latitude           float64 // Latitude
+	Longitude          float64 // Longitude
+	AltitudeRef        float64 // Altitude above user datum ellipsoid, in meters
+	NavStatus          string  // Navigation status
+	HorizontalAccuracy float64 // Horizontal accuracy estimate, in meters
+	VerticalAccuracy   float64 // Vertical accuracy estimate, in meters
+	SpeedOverGround    float64 // Speed over ground, in km/h
+	CourseOverGround   float64 // Course over ground, in degrees
+	VerticalVelocity   float64 // Vertical velocity (positive downwards), in m/s
+	DiffAge            float64 // Age of differential corrections, in seconds
+	HDOP               float64 // Horizontal dilution of precision
+	VDOP               float64 // Vertical dilution of precision
+	TDOP               float64 // Time dilution of precision
+	NumSatellites      int64   // Number of satellites used in the navigation solution
+	DeadReckoning      int64   // Dead reckoning used
+}
+
+func (s PUBX00) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"time":                s.Time.String(),
+		"time_valid":          s.Time.Valid,
+		"latitude":            roundCoordinate(s.Latitude),
+		"longitude":           roundCoordinate(s.Longitude),
+		"altitude_ref":        s.AltitudeRef,
+		"nav_status":          s.NavStatus,
+		"horizontal_accuracy": s.HorizontalAccuracy,
+		"vertical_accuracy":   s.VerticalAccuracy,
+		"speed_over_ground":   s.SpeedOverGround,
+		"course_over_ground":  s.CourseOverGround,
+		"vertical_velocity":   s.VerticalVelocity,
+		"diff_age":            s.DiffAge,
+		"hdop":                s.HDOP,
+		"vdop":                s.VDOP,
+		"tdop":                s.TDOP,
+		"num_satellites":      s.NumSatellites,
+		"dead_reckoning":      s.DeadReckoning,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// PUBX04 is the time of day and clock information (u-blox proprietary sentence)
+// https://www.u-blox.com/sites/default/files/products/documents/u-blox8-M8_ReceiverDescrProtSpec_(UBX-13003221).pdf
+type PUBX04 struct {
+	BaseSentence
+	Time                 Time    // UTC time
+	Date                 Date    // UTC date
+	UTCTimeOfWeek        float64 // UTC time of week, in seconds
+	UTCWeek              int64   // UTC week number
+	LeapSeconds          int64   // Leap seconds
+	LeapSecondsDefault   bool    // Leap seconds value is the firmware default, not yet received from a satellite
+	ClockBias            int64   // Receiver clock bias, in nanoseconds
+	ClockDrift           float64 // Receiver clock drift, in nanoseconds per second
+	TimepulseGranularity int64   // Timepulse granularity, in nanoseconds
+}
+
+func (s PUBX04) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"time":                  s.Time.String(),
+		"time_valid":            s.Time.Valid,
+		"date":                  s.Date.String(),
+		"date_valid":            s.Date.Valid,
+		"utc_time_of_week":      s.UTCTimeOfWeek,
+		"utc_week":              s.UTCWeek,
+		"leap_seconds":          s.LeapSeconds,
+		"leap_seconds_default":  s.LeapSecondsDefault,
+		"clock_bias":            s.ClockBias,
+		"clock_drift":           s.ClockDrift,
+		"timepulse_granularity": s.TimepulseGranularity,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newPUBX dispatches a PUBX sentence on its message id field.
+func newPUBX(s BaseSentence) (Sentence, error) {
+	p := NewParser(s)
+	p.AssertType(TypePUBX)
+	id := p.String(0, "message id")
+	if err := p.Err(); err != nil {
+		return nil, err
+	}
+	switch id {
+	case PositionPUBX:
+		return newPUBX00(s)
+	case TimePUBX:
+		return newPUBX04(s)
+	}
+	p.SetErr("message id", id)
+	return nil, p.Err()
+}
+
+// newPUBX00 constructor
+func newPUBX00(s BaseSentence) (PUBX00, error) {
+	p := NewParser(s)
+	p.AssertType(TypePUBX)
+	_ = p.EnumString(0, "message id", PositionPUBX)
+	return PUBX00{
+		BaseSentence:       s,
+		Time:               p.Time(1, "time"),
+		Latitude:           p.LatLong(2, 3, "latitude"),
+		Longitude:          p.LatLong(4, 5, "longitude"),
+		AltitudeRef:        p.Float64(6, "altitude"),
+		NavStatus:          p.EnumString(7, "navigation status", NoFixPUBX, DeadReckoningPUBX, StandAlone2DPUBX, StandAlone3DPUBX, Differential2DPUBX, Differential3DPUBX, CombinedPUBX, TimeOnlyPUBX),
+		HorizontalAccuracy: p.Float64(8, "horizontal accuracy"),
+		VerticalAccuracy:   p.Float64(9, "vertical accuracy"),
+		SpeedOverGround:    p.Float64(10, "speed over ground"),
+		CourseOverGround:   p.Float64(11, "course over ground"),
+		VerticalVelocity:   p.Float64(12, "vertical velocity"),
+		DiffAge:            p.Float64(13, "age of differential corrections"),
+		HDOP:               p.Float64(14, "hdop"),
+		VDOP:               p.Float64(15, "vdop"),
+		TDOP:               p.Float64(16, "tdop"),
+		NumSatellites:      p.Int64(17, "number of satellites"),
+		DeadReckoning:      p.Int64(19, "dead reckoning"),
+	}, p.Err()
+}
+
+// newPUBX04 constructor
+func newPUBX04(s BaseSentence) (PUBX04, error) {
+	p := NewParser(s)
+	p.AssertType(TypePUBX)
+	_ = p.EnumString(0, "message id", TimePUBX)
+	m := PUBX04{
+		BaseSentence:  s,
+		Time:          p.Time(1, "time"),
+		Date:          p.Date(2, "date"),
+		UTCTimeOfWeek: p.Float64(3, "utc time of week"),
+		UTCWeek:       p.Int64(4, "utc week"),
+	}
+	// The leap seconds field carries a trailing 'D' while the
+	// receiver still uses its firmware default value.
+	leap := p.String(5, "leap seconds")
+	if strings.HasSuffix(leap, "D") {
+		m.LeapSecondsDefault = true
+		leap = strings.TrimSuffix(leap, "D")
+	}
+	if leap != "" {
+		v, err := strconv.ParseInt(leap, 10, 64)
+		if err != nil {
+			p.SetErr("leap seconds", p.String(5, "leap seconds"))
+		}
+		m.LeapSeconds = v
+	}
+	m.ClockBias = p.Int64(6, "clock bias")
+	m.ClockDrift = p.Float64(7, "clock drift")
+	m.TimepulseGranularity = p.Int64(8, "timepulse granularity")
+	return m, p.Err()
+}
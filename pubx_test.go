@@ -0,0 +1,103 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pubxtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  Sentence
+}{
+	{
+		name: "good position sentence",
+		raw:  "$PUBX,00,081350.00,4717.113210,N,00833.915187,E,546.589,G3,2.1,2.0,0.007,77.52,0.007,,0.92,1.19,0.77,9,0,0*5F",
+		msg: PUBX00{
+			Time:               Time{true, 8, 13, 50, 0},
+			Latitude:           MustParseLatLong("4717.113210 N"),
+			Longitude:          MustParseLatLong("00833.915187 E"),
+			AltitudeRef:        546.589,
+			NavStatus:          StandAlone3DPUBX,
+			HorizontalAccuracy: 2.1,
+			VerticalAccuracy:   2.0,
+			SpeedOverGround:    0.007,
+			CourseOverGround:   77.52,
+			VerticalVelocity:   0.007,
+			HDOP:               0.92,
+			VDOP:               1.19,
+			TDOP:               0.77,
+			NumSatellites:      9,
+		},
+	},
+	{
+		name: "good time sentence",
+		raw:  "$PUBX,04,073731.00,091202,113851.00,1196,15D,1930035,-2660.664,43*71",
+		msg: PUBX04{
+			Time:                 Time{true, 7, 37, 31, 0},
+			Date:                 Date{true, 9, 12, 2},
+			UTCTimeOfWeek:        113851,
+			UTCWeek:              1196,
+			LeapSeconds:          15,
+			LeapSecondsDefault:   true,
+			ClockBias:            1930035,
+			ClockDrift:           -2660.664,
+			TimepulseGranularity: 43,
+		},
+	},
+	{
+		name: "good time sentence with received leap seconds",
+		raw:  "$PUBX,04,073731.00,091202,113851.00,1196,15,1930035,-2660.664,43*35",
+		msg: PUBX04{
+			Time:                 Time{true, 7, 37, 31, 0},
+			Date:                 Date{true, 9, 12, 2},
+			UTCTimeOfWeek:        113851,
+			UTCWeek:              1196,
+			LeapSeconds:          15,
+			ClockBias:            1930035,
+			ClockDrift:           -2660.664,
+			TimepulseGranularity: 43,
+		},
+	},
+	{
+		name: "invalid navigation status",
+		raw:  "$PUBX,00,081350.00,4717.113210,N,00833.915187,E,546.589,XX,2.1,2.0,0.007,77.52,0.007,,0.92,1.19,0.77,9,0,0*2B",
+		err:  "nmea: PUBX invalid navigation status: XX",
+	},
+	{
+		name: "invalid leap seconds",
+		raw:  "$PUBX,04,073731.00,091202,113851.00,1196,1XD,1930035,-2660.664,43*1C",
+		err:  "nmea: PUBX invalid leap seconds: 1XD",
+	},
+	{
+		name: "unsupported message id",
+		raw:  "$PUBX,03,1*2D",
+		err:  "nmea: PUBX invalid message id: 03",
+	},
+}
+
+func TestPUBX(t *testing.T) {
+	for _, tt := range pubxtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			switch pubx := m.(type) {
+			case PUBX00:
+				pubx.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pubx)
+			case PUBX04:
+				pubx.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pubx)
+			default:
+				t.Fatalf("unexpected sentence %T", m)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package nmea
+
+import "time"
+
+const (
+	// TypeRMB type for RMB sentences
+	TypeRMB = "RMB"
+	// ValidRMB data valid
+	ValidRMB = "A"
+	// InvalidRMB data invalid
+	InvalidRMB = "V"
+	// LeftRMB steer left to correct the cross track error
+	LeftRMB = "L"
+	// RightRMB steer right to correct the cross track error
+	RightRMB = "R"
+	// ArrivedRMB arrival circle entered or perpendicular passed
+	ArrivedRMB = "A"
+	// NotArrivedRMB destination not reached yet
+	NotArrivedRMB = "V"
+)
+
+// RMB is the recommended minimum navigation information, sent by a
+// navigation receiver when a destination waypoint is active.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_rmb_recommended_minimum_navigation_information
+type RMB struct {
+	BaseSentence
+	Status                string  // Data status, A = valid, V = invalid
+	CrossTrackError       float64 // Cross track error in nautical miles
+	SteerDirection        string  // Direction to steer, L = left, R = right
+	OriginWaypointID      string  // Origin waypoint ID
+	DestinationWaypointID string  // Destination waypoint ID
+	Latitude              float64 // Destination waypoint latitude
+	Longitude             float64 // Destination waypoint longitude
+	RangeToDestination    float64 // Range to destination in nautical miles
+	BearingToDestination  float64 // True bearing to destination in degrees
+	VelocityToDestination float64 // Destination closing velocity in knots
+	ArrivalStatus         string  // Arrival status, A = arrived, V = not arrived
+	FAAMode               string  // FAA mode indicator (NMEA 2.3 and later), empty if not sent
+}
+
+func (s RMB) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"status":                  s.Status,
+		"cross_track_error":       s.CrossTrackError,
+		"steer_direction":         s.SteerDirection,
+		"origin_waypoint_id":      s.OriginWaypointID,
+		"destination_waypoint_id": s.DestinationWaypointID,
+		"latitude":                roundCoordinate(s.Latitude),
+		"longitude":               roundCoordinate(s.Longitude),
+		"range_to_destination":    s.RangeToDestination,
+		"bearing_to_destination":  s.BearingToDestination,
+		"velocity_to_destination": s.VelocityToDestination,
+		"arrival_status":          s.ArrivalStatus,
+		"faa_mode":                s.FAAMode,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// Arrived reports whether the arrival circle of the destination waypoint
+// has been entered or its perpendicular passed.
+func (s RMB) Arrived() bool {
+	return s.ArrivalStatus == ArrivedRMB
+}
+
+// WaypointSwitched reports whether the destination waypoint changed since
+// the previous RMB sentence, e.g. when the receiver advanced along a route.
+// Sentences without a destination waypoint ID are never considered a switch.
+func (s RMB) WaypointSwitched(prev RMB) bool {
+	return s.DestinationWaypointID != "" && prev.DestinationWaypointID != "" &&
+		s.DestinationWaypointID != prev.DestinationWaypointID
+}
+
+// EstimatedTimeEnroute returns the time to reach the destination waypoint at
+// the current closing velocity. ok is false if the vessel is not closing in.
+func (s RMB) EstimatedTimeEnroute() (d time.Duration, ok bool) {
+	if s.VelocityToDestination <= 0 {
+		return 0, false
+	}
+	return time.Duration(s.RangeToDestination / s.VelocityToDestination * float64(time.Hour)), true
+}
+
+// newRMB constructor
+func newRMB(s BaseSentence) (RMB, error) {
+	p := NewParser(s)
+	p.AssertType(TypeRMB)
+	m := RMB{
+		BaseSentence:          s,
+		Status:                p.EnumString(0, "status", ValidRMB, InvalidRMB),
+		CrossTrackError:       p.Float64(1, "cross track error"),
+		SteerDirection:        p.EnumString(2, "steer direction", LeftRMB, RightRMB),
+		OriginWaypointID:      p.String(3, "origin waypoint ID"),
+		DestinationWaypointID: p.String(4, "destination waypoint ID"),
+		RangeToDestination:    p.Float64(9, "range to destination"),
+		BearingToDestination:  p.Float64(10, "bearing to destination"),
+		VelocityToDestination: p.Float64(11, "velocity to destination"),
+		ArrivalStatus:         p.EnumString(12, "arrival status", ArrivedRMB, NotArrivedRMB),
+	}
+	// The waypoint position is empty when no destination is set.
+	if m.FieldPresent(5) || m.FieldPresent(6) {
+		m.Latitude = float64(p.Latitude(5, 6, "latitude"))
+	}
+	if m.FieldPresent(7) || m.FieldPresent(8) {
+		m.Longitude = float64(p.Longitude(7, 8, "longitude"))
+	}
+	if len(m.Fields) > 13 {
+		m.FAAMode = p.EnumString(13, "FAA mode", AutonomousGNS, DifferentialGNS, EstimatedGNS, ManualGNS, SimulatorGNS, NoFixGNS, PreciseGNS, RealTimeKinematicGNS, FloatRTKGNS)
+	}
+	return m, p.Err()
+}
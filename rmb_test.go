@@ -0,0 +1,127 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var rmbtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  RMB
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V*20",
+		msg: RMB{
+			Status:                ValidRMB,
+			CrossTrackError:       0.66,
+			SteerDirection:        LeftRMB,
+			OriginWaypointID:      "003",
+			DestinationWaypointID: "004",
+			Latitude:              MustParseGPS("4917.24 N"),
+			Longitude:             MustParseGPS("12309.57 W"),
+			RangeToDestination:    1.3,
+			BearingToDestination:  52.5,
+			VelocityToDestination: 0.5,
+			ArrivalStatus:         NotArrivedRMB,
+		},
+	},
+	{
+		name: "good sentence with FAA mode",
+		raw:  "$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V,A*4D",
+		msg: RMB{
+			Status:                ValidRMB,
+			CrossTrackError:       0.66,
+			SteerDirection:        LeftRMB,
+			OriginWaypointID:      "003",
+			DestinationWaypointID: "004",
+			Latitude:              MustParseGPS("4917.24 N"),
+			Longitude:             MustParseGPS("12309.57 W"),
+			RangeToDestination:    1.3,
+			BearingToDestination:  52.5,
+			VelocityToDestination: 0.5,
+			ArrivalStatus:         NotArrivedRMB,
+			FAAMode:               AutonomousGNS,
+		},
+	},
+	{
+		name: "no active waypoint",
+		raw:  "$GPRMB,V,,,,,,,,,,,,V,N*04",
+		msg: RMB{
+			Status:        InvalidRMB,
+			ArrivalStatus: NotArrivedRMB,
+			FAAMode:       NoFixGNS,
+		},
+	},
+	{
+		name: "bad steer direction",
+		raw:  "$GPRMB,A,0.66,X,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V*34",
+		err:  "nmea: GPRMB invalid steer direction: X",
+	},
+	{
+		name: "bad latitude hemisphere",
+		raw:  "$GPRMB,A,0.66,L,003,004,4917.24,E,12309.57,W,001.3,052.5,000.5,V*2B",
+		err:  "nmea: GPRMB invalid latitude: invalid hemisphere [E]",
+	},
+	{
+		name: "bad FAA mode",
+		raw:  "$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V,Z*56",
+		err:  "nmea: GPRMB invalid FAA mode: Z",
+	},
+}
+
+func TestRMB(t *testing.T) {
+	for _, tt := range rmbtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				rmb := m.(RMB)
+				rmb.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, rmb)
+			}
+		})
+	}
+}
+
+func TestRMBRouteFollowing(t *testing.T) {
+	var msgs []RMB
+	for _, raw := range []string{
+		"$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V*20",
+		"$GPRMB,A,0.01,R,003,004,4917.24,N,12309.57,W,000.0,052.5,000.5,A*2A",
+		"$GPRMB,A,0.66,L,004,005,4920.00,N,12310.00,W,002.4,010.0,000.5,V*29",
+		"$GPRMB,V,,,,,,,,,,,,V,N*04",
+	} {
+		m, err := Parse(raw)
+		assert.NoError(t, err)
+		msgs = append(msgs, m.(RMB))
+	}
+
+	assert.False(t, msgs[0].Arrived())
+	assert.True(t, msgs[1].Arrived())
+	assert.False(t, msgs[2].Arrived())
+
+	assert.False(t, msgs[1].WaypointSwitched(msgs[0]))
+	assert.True(t, msgs[2].WaypointSwitched(msgs[1]))
+	assert.False(t, msgs[3].WaypointSwitched(msgs[2]))
+}
+
+func TestRMBEstimatedTimeEnroute(t *testing.T) {
+	m, err := Parse("$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V*20")
+	assert.NoError(t, err)
+	d, ok := m.(RMB).EstimatedTimeEnroute()
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Hour+36*time.Minute, d.Round(time.Second))
+
+	m, err = Parse("$GPRMB,V,,,,,,,,,,,,V,N*04")
+	assert.NoError(t, err)
+	_, ok = m.(RMB).EstimatedTimeEnroute()
+	assert.False(t, ok)
+}
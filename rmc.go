@@ -1,5 +1,11 @@
 package nmea
 
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
 const (
 	// TypeRMC type for RMC sentences
 	TypeRMC = "RMC"
@@ -19,7 +25,7 @@ type RMC struct {
 	Longitude float64 // Longitude
 	Speed     float64 // Speed in knots
 	Course    float64 // True course
-	Date      Date    // Date
+	Date      Date    // Date, invalid when not reported (e.g. during a cold start)
 	Variation float64 // Magnetic variation
 }
 
@@ -28,8 +34,8 @@ func (s RMC) ToMap() (map[string]interface{}, error) {
 		"time":       s.Time.String(),
 		"time_valid": s.Time.Valid,
 		"validity":   s.Validity,
-		"latitude":   s.Latitude,
-		"longitude":  s.Longitude,
+		"latitude":   roundCoordinate(s.Latitude),
+		"longitude":  roundCoordinate(s.Longitude),
 		"speed":      s.Speed,
 		"course":     s.Course,
 		"date":       s.Date.String(),
@@ -46,6 +52,12 @@ func (s RMC) ToMap() (map[string]interface{}, error) {
 	return m, nil
 }
 
+// DateTime returns the UTC timestamp of the fix.
+// The zero time.Time is returned if either the time or the date is invalid.
+func (s RMC) DateTime() time.Time {
+	return dateTime(s.Date, s.Time)
+}
+
 // newRMC constructor
 func newRMC(s BaseSentence) (RMC, error) {
 	p := NewParser(s)
@@ -66,3 +78,38 @@ func newRMC(s BaseSentence) (RMC, error) {
 	}
 	return m, p.Err()
 }
+
+// Render formats the sentence back into a checksummed raw sentence.
+// Numeric fields keep the number of decimals of the parsed fields, so
+// sentences are reproduced as received, and fields that were empty,
+// such as the position of a sentence without a fix, stay empty.
+func (s RMC) Render() string {
+	date := ""
+	if s.Date.Valid {
+		date = fmt.Sprintf("%02d%02d%02d", s.Date.DD, s.Date.MM, s.Date.YY)
+	}
+	direction := s.field(10)
+	if s.Variation < 0 {
+		direction = West
+	} else if s.Variation > 0 {
+		direction = East
+	}
+	lat, ns := formatGPSAs(s.Latitude, s.field(2), s.field(3), 2, North, South)
+	lon, ew := formatGPSAs(s.Longitude, s.field(4), s.field(5), 3, East, West)
+	fields := []string{
+		formatTimeAs(s.Time, s.field(0)),
+		s.Validity,
+		lat, ns,
+		lon, ew,
+		formatFloatAs(s.Speed, s.field(6)),
+		formatFloatAs(s.Course, s.field(7)),
+		date,
+		formatFloatAs(math.Abs(s.Variation), s.field(9)), direction,
+	}
+	if len(s.Fields) > 11 {
+		// fields added by later NMEA versions, such as the FAA mode
+		fields = append(fields, s.Fields[11:]...)
+	}
+	raw, _ := serialize(s.start(), s.Prefix(), fields)
+	return raw
+}
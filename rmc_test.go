@@ -2,6 +2,7 @@ package nmea
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -54,6 +55,20 @@ var rmctests = []struct {
 			Longitude: MustParseGPS("03736.91144 E"),
 		},
 	},
+	{
+		name: "empty date",
+		raw:  "$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,,15.2,E,A*07",
+		msg: RMC{
+			Time:      Time{true, 23, 52, 36, 0},
+			Validity:  "A",
+			Speed:     44.7,
+			Course:    153.6,
+			Date:      Date{},
+			Variation: 15.2,
+			Latitude:  MustParseGPS("3925.9479 N"),
+			Longitude: MustParseGPS("11945.9211 W"),
+		},
+	},
 	{
 		name: "bad sentence",
 		raw:  "$GNRMC,220516,D,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6B",
@@ -110,3 +125,63 @@ func TestRMC(t *testing.T) {
 		})
 	}
 }
+
+func TestRMCDateTimeLeapSecond(t *testing.T) {
+	m, err := Parse("$GPRMC,235960,A,3925.9479,N,11945.9211,W,44.7,153.6,311216,15.2,E,A*09")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC), m.(RMC).DateTime())
+	assert.True(t, RMC{}.DateTime().IsZero())
+}
+
+func TestRMCRender(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		time Time
+	}{
+		{"no fraction", "$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E*61", Time{true, 23, 52, 36, 0}},
+		{"hundredths", "$GPRMC,235236.12,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E*4C", Time{true, 23, 52, 36, 120}},
+		{"zero hundredths", "$GPRMC,235236.00,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E*4F", Time{true, 23, 52, 36, 0}},
+		{"thousandths", "$GPRMC,235236.123,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E*7F", Time{true, 23, 52, 36, 123}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			assert.NoError(t, err)
+			rmc := m.(RMC)
+			assert.Equal(t, tt.time, rmc.Time)
+			assert.Equal(t, tt.raw, rmc.Render())
+		})
+	}
+}
+
+func TestRMCRenderRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{"variation with leading zeros", "$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E"},
+		{"FAA mode", "$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0C"},
+		{"unknown FAA mode", "$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,X*15"},
+		{"empty course", "$GNRMC,142754.0,A,4302.539570,N,07920.379823,W,0.0,,070617,0.0,E,A*21"},
+		{"empty variation", "$GNRMC,100538.00,A,5546.27711,N,03736.91144,E,0.061,,260318,,,A*60"},
+		{"no fix", "$GPRMC,,V,,,,,,,,,,N*53"},
+		{"no fix with time", "$GPRMC,123519,V,,,,,,,,,,N*5E"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.raw, m.(RMC).Render())
+		})
+	}
+}
+
+func TestRMCRenderModified(t *testing.T) {
+	m, err := Parse("$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E")
+	assert.NoError(t, err)
+	rmc := m.(RMC)
+	rmc.Latitude = -rmc.Latitude
+	rmc.Speed = 5.31
+	assert.Equal(t, "$GNRMC,220516,A,5133.8200,S,00042.24,W,5.3,231.8,130694,004.2,W*78", rmc.Render())
+}
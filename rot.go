@@ -3,6 +3,10 @@ package nmea
 const (
 	// TypeROT type for ROT sentences
 	TypeROT = "ROT"
+	// ValidROT data valid
+	ValidROT = "A"
+	// InvalidROT data invalid
+	InvalidROT = "V"
 )
 
 // ROT rate of turn
@@ -10,7 +14,7 @@ const (
 type ROT struct {
 	BaseSentence
 	Rate   float64 // rate of turn, degrees/minute, "-" bow turns to port
-	Status string
+	Status string  // status, A = valid, V = invalid
 }
 
 func (s ROT) ToMap() (map[string]interface{}, error) {
@@ -35,7 +39,14 @@ func newROT(s BaseSentence) (ROT, error) {
 	m := ROT{
 		BaseSentence: s,
 		Rate:         p.Float64(0, "Rate"),
-		Status:       p.String(1, "Status"),
+		Status:       p.EnumString(1, "Status", ValidROT, InvalidROT),
 	}
 	return m, p.Err()
 }
+
+// Render formats the sentence back into a checksummed raw sentence.
+// The rate keeps the decimals and leading zeros of the parsed field.
+func (s ROT) Render() string {
+	raw, _ := serialize(s.start(), s.Prefix(), []string{formatFloatAs(s.Rate, s.field(0)), s.Status})
+	return raw
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
 )
 
 func Test_newROT(t *testing.T) {
@@ -39,3 +40,21 @@ func Test_newROT(t *testing.T) {
 		})
 	}
 }
+
+func TestROTRender(t *testing.T) {
+	for _, raw := range []string{
+		"$HEROT,-0.75,A*34",
+		"$HEROT,12.5,A*1D",
+		"$HEROT,010.0,A*2A",
+		"$HEROT,,V*12",
+	} {
+		m, err := Parse(raw)
+		assert.NoError(t, err)
+		assert.Equal(t, raw, m.(ROT).Render())
+	}
+}
+
+func TestROTInvalidStatus(t *testing.T) {
+	_, err := Parse(makeSentence("$BDROT,100.1,X"))
+	assert.EqualError(t, err, "nmea: BDROT invalid Status: X")
+}
@@ -0,0 +1,85 @@
+package nmea
+
+import (
+	"bufio"
+	"io"
+	"log"
+	"strings"
+	"time"
+)
+
+// Scanner reads NMEA sentences from an io.Reader one line at a time.
+type Scanner struct {
+	// Logger, if set, records every line that could not be parsed
+	// together with its line number and the parse error.
+	Logger *log.Logger
+
+	// Reconnect makes a Scanner returned by DialScanner redial when its
+	// connection is closed or fails instead of ending the scan.
+	Reconnect bool
+	// ReconnectDelay is the delay before the first reconnection attempt,
+	// doubled after each failed attempt up to a minute. Defaults to a second.
+	ReconnectDelay time.Duration
+	// MaxReconnectAttempts is the number of consecutive failed reconnection
+	// attempts after which the scan ends. Zero means no limit.
+	MaxReconnectAttempts int
+
+	conn     *dialReader
+	scanner  *bufio.Scanner
+	line     int
+	sentence Sentence
+	err      error
+}
+
+// NewScanner returns a new Scanner reading from r.
+func NewScanner(r io.Reader) *Scanner {
+	return &Scanner{scanner: bufio.NewScanner(r)}
+}
+
+// Scan advances the scanner to the next sentence line and parses it.
+// Lines may end with "\r\n" or a bare "\n". Blank lines and lines that do
+// not start with '$', '!' or a TAG block are skipped. A line that fails to
+// parse does not stop the scan, its error is returned by Sentence instead.
+// Scan returns false when the end of the input is reached or the underlying
+// reader fails.
+func (s *Scanner) Scan() bool {
+	for s.scanner.Scan() {
+		s.line++
+		raw, ok := sentenceLine(s.scanner.Text())
+		if !ok {
+			continue
+		}
+		s.sentence, s.err = Parse(raw)
+		if s.err != nil && s.Logger != nil {
+			s.Logger.Printf("nmea: line %d: %v: %q", s.line, s.err, raw)
+		}
+		return true
+	}
+	s.sentence, s.err = nil, nil
+	return false
+}
+
+// Sentence returns the sentence parsed by the most recent call to Scan
+// along with its parse error, if any.
+func (s *Scanner) Sentence() (Sentence, error) {
+	return s.sentence, s.err
+}
+
+// Line returns the line number of the most recently scanned line.
+func (s *Scanner) Line() int {
+	return s.line
+}
+
+// Err returns the first non-EOF error encountered by the underlying reader.
+func (s *Scanner) Err() error {
+	return s.scanner.Err()
+}
+
+// sentenceLine trims a trailing "\r" from the line and reports whether
+// it starts with '$', '!' or a TAG block.
+func sentenceLine(line string) (string, bool) {
+	raw := strings.TrimRight(line, "\r")
+	return raw, strings.HasPrefix(raw, SentenceStart) ||
+		strings.HasPrefix(raw, SentenceStartEncapsulated) ||
+		strings.HasPrefix(raw, TagBlockSep)
+}
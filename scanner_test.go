@@ -0,0 +1,82 @@
+package nmea
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanner(t *testing.T) {
+	input := strings.Join([]string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*52",
+		"$INTHS,123.456,A*20",
+	}, "\n")
+	s := NewScanner(strings.NewReader(input))
+
+	assert.True(t, s.Scan())
+	sentence, err := s.Sentence()
+	assert.NoError(t, err)
+	assert.Equal(t, TypeGGA, sentence.DataType())
+
+	assert.True(t, s.Scan())
+	_, err = s.Sentence()
+	assert.EqualError(t, err, "nmea: sentence checksum mismatch [51 != 52]")
+	assert.Equal(t, 2, s.Line())
+
+	assert.True(t, s.Scan())
+	sentence, err = s.Sentence()
+	assert.NoError(t, err)
+	assert.Equal(t, TypeTHS, sentence.DataType())
+
+	assert.False(t, s.Scan())
+	assert.NoError(t, s.Err())
+}
+
+func TestScannerLogger(t *testing.T) {
+	input := strings.Join([]string{
+		"$INTHS,123.456,A*20",
+		"$INTHS,123.456,A*21",
+	}, "\n")
+	var buf bytes.Buffer
+	s := NewScanner(strings.NewReader(input))
+	s.Logger = log.New(&buf, "", 0)
+	for s.Scan() {
+	}
+	assert.NoError(t, s.Err())
+	assert.Equal(t,
+		"nmea: line 2: nmea: sentence checksum mismatch [20 != 21]: \"$INTHS,123.456,A*21\"\n",
+		buf.String())
+}
+
+func TestScannerSkipsNonSentenceLines(t *testing.T) {
+	input := "\r\n" +
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51\r\n" +
+		"   \n" +
+		"garbage line\r\n" +
+		"!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55\n" +
+		"$INTHS,123.456,A*21\r\n" +
+		"\n" +
+		"$INTHS,123.456,A*20"
+	s := NewScanner(strings.NewReader(input))
+
+	var types []string
+	var lines []int
+	var errs int
+	for s.Scan() {
+		sentence, err := s.Sentence()
+		lines = append(lines, s.Line())
+		if err != nil {
+			errs++
+			continue
+		}
+		types = append(types, sentence.DataType())
+	}
+	assert.NoError(t, s.Err())
+	assert.Equal(t, []string{TypeGGA, TypeVDM, TypeTHS}, types)
+	assert.Equal(t, []int{2, 5, 6, 8}, lines)
+	assert.Equal(t, 1, errs)
+}
@@ -1,7 +1,10 @@
 package nmea
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -17,31 +20,62 @@ const (
 
 	// ChecksumSep is the token to delimit the checksum of a sentence.
 	ChecksumSep = "*"
+
+	// TalkerProprietary is the character starting the address field of proprietary
+	// sentences, followed by the manufacturer mnemonic.
+	TalkerProprietary = "P"
 )
 
+// ChecksumError is returned by ParseSentence when the checksum of a sentence
+// does not match its content, e.g. because it was corrupted on the wire.
+type ChecksumError struct {
+	Expected string // Checksum computed from the sentence content
+	Received string // Checksum carried by the sentence
+}
+
+func (e ChecksumError) Error() string {
+	return fmt.Sprintf("nmea: sentence checksum mismatch [%s != %s]", e.Expected, e.Received)
+}
+
 // Sentence interface for all NMEA sentence
 type Sentence interface {
 	fmt.Stringer
 	Prefix() string
 	DataType() string
 	TalkerID() string
+	FieldCount() int
 	ToMap() (map[string]interface{}, error)
 }
 
 // BaseSentence contains the information about the NMEA sentence
 type BaseSentence struct {
-	Talker   string   // The talker id (e.g GP)
-	Type     string   // The data type (e.g GSA)
-	Fields   []string // Array of fields
-	Checksum string   // The Checksum
-	Raw      string   // The raw NMEA sentence received
+	Talker      string   // The talker id (e.g GP), or the manufacturer mnemonic of proprietary sentences (e.g GRM)
+	Type        string   // The data type (e.g GSA)
+	Fields      []string // Array of fields
+	Checksum    string   // The Checksum
+	Raw         string   // The raw NMEA sentence received, without its TAG block
+	TagBlock    TagBlock // The TAG block, if any
+	Proprietary bool     // Whether the sentence is a proprietary $P sentence
 }
 
-// Prefix returns the talker and type of message
+// Prefix returns the talker and type of message,
+// preceded by "P" for proprietary sentences
 func (s BaseSentence) Prefix() string {
+	if s.Proprietary {
+		return TalkerProprietary + s.Talker + s.Type
+	}
 	return s.Talker + s.Type
 }
 
+// key returns the type the sentence is dispatched on: its data type, or
+// its manufacturer mnemonic and data type for proprietary sentences (e.g. GRME).
+func (s BaseSentence) key() string {
+	if s.Proprietary {
+		return s.Talker + s.Type
+	}
+	return s.Type
+}
+
 // DataType returns the type of the message
 func (s BaseSentence) DataType() string {
 	return s.Type
@@ -52,9 +86,111 @@ func (s BaseSentence) TalkerID() string {
 	return s.Talker
 }
 
+// FieldCount returns the number of fields of the message
+func (s BaseSentence) FieldCount() int {
+	return len(s.Fields)
+}
+
+// FieldPresent reports whether the field at the given index exists and is not empty
+func (s BaseSentence) FieldPresent(i int) bool {
+	return i >= 0 && i < len(s.Fields) && s.Fields[i] != ""
+}
+
+// PopulatedFields returns the indices of the fields that are not empty.
+func (s BaseSentence) PopulatedFields() []int {
+	indices := []int{}
+	for i, f := range s.Fields {
+		if f != "" {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
 // String formats the sentence into a string
 func (s BaseSentence) String() string { return s.Raw }
 
+// WithTalker returns a copy of the sentence with the talker id replaced
+// and the raw sentence and checksum recomputed accordingly.
+func (s BaseSentence) WithTalker(id string) BaseSentence {
+	s.Talker = id
+	s.Fields = append([]string{}, s.Fields...)
+	s.Raw, s.Checksum = serialize(s.start(), s.Prefix(), s.Fields)
+	return s
+}
+
+// Encapsulated reports whether the sentence starts with '!', as used for
+// encapsulated data such as AIS, rather than '$'.
+func (s BaseSentence) Encapsulated() bool {
+	return strings.HasPrefix(s.Raw, SentenceStartEncapsulated)
+}
+
+// start returns the start token of the raw sentence.
+func (s BaseSentence) start() string {
+	if s.Encapsulated() {
+		return SentenceStartEncapsulated
+	}
+	return SentenceStart
+}
+
+// field returns the field at the given index, or an empty string if it does not exist.
+func (s BaseSentence) field(i int) string {
+	if i < 0 || i >= len(s.Fields) {
+		return ""
+	}
+	return s.Fields[i]
+}
+
+// baseSentence returns the sentence itself. It lets the BaseSentence be
+// read from any Sentence embedding it.
+func (s BaseSentence) baseSentence() BaseSentence {
+	return s
+}
+
+// Render formats any sentence embedding BaseSentence into a checksummed
+// raw sentence, built from its talker id, data type and fields.
+// Changes to the typed fields of the sentence are not reflected.
+func Render(s Sentence) (string, error) {
+	b, ok := s.(interface{ baseSentence() BaseSentence })
+	if !ok {
+		return "", fmt.Errorf("nmea: cannot render sentence of type %T", s)
+	}
+	base := b.baseSentence()
+	raw, _ := serialize(base.start(), base.Prefix(), base.Fields)
+	return raw, nil
+}
+
+// Encode joins the prefix (talker id and data type, e.g. "GPZDA") and the
+// fields of a sentence into a checksummed raw sentence. The prefix is the
+// first element of fields.
+func Encode(fields []string) (string, error) {
+	if len(fields) == 0 || fields[0] == "" {
+		return "", fmt.Errorf("nmea: cannot encode sentence without prefix")
+	}
+	for _, f := range fields {
+		if strings.ContainsAny(f, reservedChars) {
+			return "", fmt.Errorf("nmea: cannot encode field %q", f)
+		}
+	}
+	raw, _ := serialize(SentenceStart, fields[0], fields[1:])
+	return raw, nil
+}
+
+// reservedChars are the characters that cannot appear in a field value.
+const reservedChars = SentenceStart + SentenceStartEncapsulated + ChecksumSep + FieldSep + TagBlockSep + "\r\n"
+
+// ToJSON encodes the sentence as a JSON object built from its ToMap
+// representation, so the keys are those of ToMap and include the talker,
+// type, fields, checksum and raw sentence. Times and dates are encoded
+// in their String form.
+func ToJSON(s Sentence) ([]byte, error) {
+	m, err := s.ToMap()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
+
 func (s BaseSentence) toMap() (map[string]interface{}, error) {
 	m := map[string]interface{}{
 		"talker":   s.Talker,
@@ -66,46 +202,228 @@ func (s BaseSentence) toMap() (map[string]interface{}, error) {
 	return m, nil
 }
 
-// parseSentence parses a raw message into it's fields
+// Sanitize removes every byte outside the printable ASCII range (0x20-0x7E)
+// from the raw sentence. It can be used to clean up control characters
+// injected by serial line noise before calling Parse.
+func Sanitize(raw string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7E {
+			return -1
+		}
+		return r
+	}, raw)
+}
+
+// TrimToStart discards everything before the first '$' or '!' start token,
+// such as junk bytes a serial stream emits before a sentence. A well-formed
+// TAG block directly preceding the sentence is kept.
+// The raw sentence is returned unchanged if it has no start token.
+func TrimToStart(raw string) string {
+	for i := 0; i < len(raw); i++ {
+		switch raw[i] {
+		case SentenceStart[0], SentenceStartEncapsulated[0]:
+			return raw[i:]
+		case TagBlockSep[0]:
+			if startsWithTagBlock(raw[i:]) {
+				return raw[i:]
+			}
+		}
+	}
+	return raw
+}
+
+// startsWithTagBlock reports whether raw begins with a valid TAG block
+// followed by a sentence start token.
+func startsWithTagBlock(raw string) bool {
+	parts := strings.SplitN(raw[1:], TagBlockSep, 2)
+	if len(parts) != 2 || strings.IndexAny(parts[1], SentenceStart+SentenceStartEncapsulated) != 0 {
+		return false
+	}
+	_, err := parseTagBlock(parts[0])
+	return err == nil
+}
+
+// ParseSentence parses a raw message into its fields
+// and validates its checksum. The checksum follows the last '*' of the
+// sentence, and a leading TAG block is validated against its own checksum.
 func ParseSentence(raw string) (BaseSentence, error) {
+	return parseSentence(raw, false)
+}
+
+// ParseSentenceLenient is like ParseSentence but also accepts sentences
+// without a checksum, as sent by some GPS modules and NMEA-over-UDP bridges.
+// The Checksum of such sentences is left empty. A checksum that is present
+// is still validated.
+func ParseSentenceLenient(raw string) (BaseSentence, error) {
+	return parseSentence(raw, true)
+}
+
+// parseSentence parses a raw message into it's fields
+func parseSentence(raw string, lenient bool) (BaseSentence, error) {
+	var tagBlock TagBlock
+	if strings.HasPrefix(raw, TagBlockSep) {
+		parts := strings.SplitN(raw[1:], TagBlockSep, 2)
+		if len(parts) != 2 {
+			return BaseSentence{}, fmt.Errorf("nmea: tag block is not terminated")
+		}
+		var err error
+		if tagBlock, err = parseTagBlock(parts[0]); err != nil {
+			return BaseSentence{}, err
+		}
+		raw = parts[1]
+	}
 	startIndex := strings.IndexAny(raw, SentenceStart+SentenceStartEncapsulated)
 	if startIndex != 0 {
 		return BaseSentence{}, fmt.Errorf("nmea: sentence does not start with a '$' or '!'")
 	}
-	sumSepIndex := strings.Index(raw, ChecksumSep)
-	if sumSepIndex == -1 {
+	sumSepIndex := strings.LastIndex(raw, ChecksumSep)
+	if sumSepIndex == -1 && !lenient {
 		return BaseSentence{}, fmt.Errorf("nmea: sentence does not contain checksum separator")
 	}
-	var (
-		fieldsRaw   = raw[startIndex+1 : sumSepIndex]
-		fields      = strings.Split(fieldsRaw, FieldSep)
-		checksumRaw = strings.ToUpper(raw[sumSepIndex+1:sumSepIndex+2])
-		checksum    = xorChecksum(fieldsRaw)
-	)
-	// Validate the checksum
-	if checksum != checksumRaw {
-		return BaseSentence{}, fmt.Errorf(
-			"nmea: sentence checksum mismatch [%s != %s]", checksum, checksumRaw)
-	}
-	talker, typ := parsePrefix(fields[0])
+	fieldsRaw, checksumRaw := raw[startIndex+1:], ""
+	if sumSepIndex != -1 {
+		if len(raw) < sumSepIndex+3 {
+			return BaseSentence{}, fmt.Errorf("nmea: sentence checksum is truncated")
+		}
+		fieldsRaw = raw[startIndex+1 : sumSepIndex]
+		checksumRaw = strings.ToUpper(raw[sumSepIndex+1 : sumSepIndex+3])
+		// Validate the checksum, computed over everything between the start
+		// delimiter and the checksum separator. For VDM/VDO sentences this
+		// includes the fill bits, the last field before the '*'.
+		if checksum := xorChecksum(fieldsRaw); checksum != checksumRaw {
+			return BaseSentence{}, ChecksumError{Expected: checksum, Received: checksumRaw}
+		}
+	}
+	fields := strings.Split(fieldsRaw, FieldSep)
+	talker, typ, proprietary := parsePrefix(fields[0])
+	if proprietary && talker == "" {
+		return BaseSentence{}, fmt.Errorf("nmea: proprietary sentence has no type")
+	}
 	return BaseSentence{
-		Talker:   talker,
-		Type:     typ,
-		Fields:   fields[1:],
-		Checksum: checksumRaw,
-		Raw:      raw,
+		Talker:      talker,
+		Type:        typ,
+		Fields:      fields[1:],
+		Checksum:    checksumRaw,
+		Raw:         raw,
+		TagBlock:    tagBlock,
+		Proprietary: proprietary,
 	}, nil
 }
 
+// PeekType returns the talker id and data type of the raw sentence by only
+// reading its address field. Unlike ParseSentence it neither validates the
+// checksum nor splits the remaining fields, so it is cheap enough to route
+// sentences before fully parsing them. A well-formed leading TAG block is
+// skipped. ok is false if the address field is missing.
+func PeekType(raw string) (talker, typ string, ok bool) {
+	if strings.HasPrefix(raw, TagBlockSep) {
+		if !startsWithTagBlock(raw) {
+			return "", "", false
+		}
+		raw = raw[strings.Index(raw[1:], TagBlockSep)+2:]
+	}
+	if !strings.HasPrefix(raw, SentenceStart) && !strings.HasPrefix(raw, SentenceStartEncapsulated) {
+		return "", "", false
+	}
+	end := strings.IndexAny(raw, FieldSep+ChecksumSep)
+	if end <= 1 {
+		return "", "", false
+	}
+	talker, typ, proprietary := parsePrefix(raw[1:end])
+	if typ == "" && (!proprietary || talker == "") {
+		return "", "", false
+	}
+	return talker, typ, true
+}
+
+// threeLetterTalkers holds the registered three-letter talker ids.
+var threeLetterTalkers = map[string]bool{}
+
+// RegisterTalker registers a three-letter talker id (e.g. "XYZ") so the
+// address field of its sentences is split after the third character
+// instead of the second. The split is only taken if the remaining data
+// type is supported or registered, so an id overlapping a two-letter
+// talker (e.g. "AIV" and AIVDM) does not change how those sentences parse.
+// It panics if the id is not three characters long.
+// It is not safe to call concurrently with parsing and is meant to be
+// called during initialization.
+func RegisterTalker(id string) {
+	if len(id) != 3 {
+		panic(fmt.Sprintf("nmea: talker id %q is not three characters long", id))
+	}
+	threeLetterTalkers[id] = true
+}
+
+// knownType reports whether a parser is available for the
+// non-proprietary data type typ.
+func knownType(typ string) bool {
+	for _, m := range []map[string]parserFunc{parsers, encapsulatedParsers, registeredParsers} {
+		if _, ok := m[typ]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 // parsePrefix takes the first field and splits it into a talker id and data type.
-func parsePrefix(s string) (string, string) {
-	if strings.HasPrefix(s, "P") {
-		return "P", s[1:]
+// The talker id of proprietary sentences is the manufacturer mnemonic of up to
+// three characters following the "P", e.g. GRM for PGRME.
+func parsePrefix(s string) (talker, typ string, proprietary bool) {
+	if len(s) > 3 && threeLetterTalkers[s[:3]] && knownType(s[3:]) {
+		return s[:3], s[3:], false
+	}
+	if strings.HasPrefix(s, TalkerProprietary) {
+		if len(s) < 4 {
+			return s[1:], "", true
+		}
+		return s[1:4], s[4:], true
 	}
 	if len(s) < 2 {
-		return s, ""
+		return s, "", false
+	}
+	return s[:2], s[2:], false
+}
+
+// serialize joins the prefix and fields into a raw sentence starting with
+// the given start token and returns it along with its checksum.
+func serialize(start, prefix string, fields []string) (raw, checksum string) {
+	body := strings.Join(append([]string{prefix}, fields...), FieldSep)
+	checksum = xorChecksum(body)
+	return start + body + ChecksumSep + checksum, checksum
+}
+
+// formatFloat formats a field value with the fewest digits needed
+// to represent it exactly, preserving the precision it was parsed with.
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// formatFloatAs formats v with as many decimals as the field value orig,
+// zero-padded to its width if orig has leading zeros (e.g. 004.2), so a
+// parsed field is reproduced as received. An empty orig with a zero v
+// yields an empty field.
+func formatFloatAs(v float64, orig string) string {
+	if orig == "" {
+		if v == 0 {
+			return ""
+		}
+		return formatFloat(v)
+	}
+	width := 0
+	if digits := strings.TrimPrefix(orig, "-"); len(digits) > 1 && digits[0] == '0' && digits[1] != '.' {
+		width = len(orig)
 	}
-	return s[:2], s[2:]
+	return fmt.Sprintf("%0*.*f", width, fieldDecimals(orig), v)
+}
+
+// formatIntAs formats v zero-padded to the width of the field value orig,
+// so a parsed field is reproduced as received. An empty orig with a zero v
+// yields an empty field.
+func formatIntAs(v int64, orig string) string {
+	if orig == "" && v == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%0*d", len(orig), v)
 }
 
 // xor all the bytes in a string an return it
@@ -118,71 +436,221 @@ func xorChecksum(s string) string {
 	return fmt.Sprintf("%02X", checksum)
 }
 
+// parserFunc constructs a sentence from its parsed base sentence.
+type parserFunc func(BaseSentence) (Sentence, error)
+
+var (
+	// parsers maps the data type of standard sentences to their constructor.
+	parsers map[string]parserFunc
+	// proprietaryParsers maps the data type of proprietary sentences to their constructor.
+	proprietaryParsers map[string]parserFunc
+	// encapsulatedParsers maps the data type of encapsulated sentences to their constructor.
+	// The talker is not part of the key, so AIS data from any talker (e.g. AI, BS or AB) is accepted.
+	encapsulatedParsers map[string]parserFunc
+	// registeredParsers maps the data type of user defined sentences to
+	// their constructor, see RegisterParser.
+	registeredParsers = map[string]parserFunc{}
+	// minFields maps the data type of sentences to the minimum number of
+	// fields Parse requires before calling their constructor.
+	minFields = map[string]int{
+		TypeAPB: 14,
+		TypeGGA: 14,
+		TypeGLL: 4,
+		TypeGSA: 17,
+		TypeHDT: 2,
+		TypeRMB: 13,
+		TypeRMC: 11,
+		TypeVTG: 7,
+		TypeXTE: 5,
+		TypeZDA: 6,
+	}
+)
+
+// RegisterMinFields sets the minimum number of fields Parse requires for
+// sentences of the given data type (e.g. "GGA"). A count of zero removes
+// the requirement. It is not safe to call concurrently with parsing and
+// is meant to be called during initialization.
+func RegisterMinFields(typ string, n int) {
+	if n <= 0 {
+		delete(minFields, typ)
+		return
+	}
+	minFields[typ] = n
+}
+
+// checkMinFields returns an error if the sentence has fewer fields than
+// required for its data type.
+func checkMinFields(s BaseSentence) error {
+	if n, ok := minFields[s.key()]; ok && len(s.Fields) < n {
+		return fmt.Errorf("nmea: %s has %d fields, want at least %d", s.Prefix(), len(s.Fields), n)
+	}
+	return nil
+}
+
+func init() {
+	parsers = map[string]parserFunc{
+		TypeALC: func(s BaseSentence) (Sentence, error) { return newALC(s) },
+		TypeALF: func(s BaseSentence) (Sentence, error) { return newALF(s) },
+		TypeALR: func(s BaseSentence) (Sentence, error) { return newALR(s) },
+		TypeARC: func(s BaseSentence) (Sentence, error) { return newARC(s) },
+		TypeDBK: func(s BaseSentence) (Sentence, error) { return newDBK(s) },
+		TypeDBS: func(s BaseSentence) (Sentence, error) { return newDBS(s) },
+		TypeDBT: func(s BaseSentence) (Sentence, error) { return newDBT(s) },
+		TypeDPT: func(s BaseSentence) (Sentence, error) { return newDPT(s) },
+		TypeHBT: func(s BaseSentence) (Sentence, error) { return newHBT(s) },
+		TypeHDG: func(s BaseSentence) (Sentence, error) { return newHDG(s) },
+		TypeRMC: func(s BaseSentence) (Sentence, error) { return newRMC(s) },
+		TypeROT: func(s BaseSentence) (Sentence, error) { return newROT(s) },
+		TypeGGA: func(s BaseSentence) (Sentence, error) { return newGGA(s, false) },
+		TypeGSA: func(s BaseSentence) (Sentence, error) { return newGSA(s) },
+		TypeGLL: func(s BaseSentence) (Sentence, error) { return newGLL(s) },
+		TypeVTG: func(s BaseSentence) (Sentence, error) { return newVTG(s) },
+		TypeZDA: func(s BaseSentence) (Sentence, error) { return newZDA(s) },
+		TypeGSV: func(s BaseSentence) (Sentence, error) { return newGSV(s) },
+		TypeHDT: func(s BaseSentence) (Sentence, error) { return newHDT(s) },
+		TypeGNS: func(s BaseSentence) (Sentence, error) { return newGNS(s) },
+		TypeTHS: func(s BaseSentence) (Sentence, error) { return newTHS(s) },
+		TypeWPL: func(s BaseSentence) (Sentence, error) { return newWPL(s) },
+		TypeRTE: func(s BaseSentence) (Sentence, error) { return newRTE(s) },
+		TypeVHW: func(s BaseSentence) (Sentence, error) { return newVHW(s) },
+		TypeMWV: func(s BaseSentence) (Sentence, error) { return newMWV(s) },
+		TypeMTW: func(s BaseSentence) (Sentence, error) { return newMTW(s) },
+		TypeVLW: func(s BaseSentence) (Sentence, error) { return newVLW(s) },
+		TypeMWD: func(s BaseSentence) (Sentence, error) { return newMWD(s) },
+		TypeVBW: func(s BaseSentence) (Sentence, error) { return newVBW(s) },
+		TypeGST: func(s BaseSentence) (Sentence, error) { return newGST(s) },
+		TypeGBS: func(s BaseSentence) (Sentence, error) { return newGBS(s) },
+		TypeRMB: func(s BaseSentence) (Sentence, error) { return newRMB(s) },
+		TypeAPB: func(s BaseSentence) (Sentence, error) { return newAPB(s) },
+		TypeXTE: func(s BaseSentence) (Sentence, error) { return newXTE(s) },
+		TypeTXT: func(s BaseSentence) (Sentence, error) { return newTXT(s) },
+		TypeDTM: func(s BaseSentence) (Sentence, error) { return newDTM(s) },
+		TypeGRS: func(s BaseSentence) (Sentence, error) { return newGRS(s) },
+		TypeVDR: func(s BaseSentence) (Sentence, error) { return newVDR(s) },
+		TypeVWR: func(s BaseSentence) (Sentence, error) { return newVWR(s) },
+		TypeBOD: func(s BaseSentence) (Sentence, error) { return newBOD(s) },
+		TypeBWC: func(s BaseSentence) (Sentence, error) { return newBWC(s) },
+		TypeAAM: func(s BaseSentence) (Sentence, error) { return newAAM(s) },
+	}
+	proprietaryParsers = map[string]parserFunc{
+		TypePGRME:   func(s BaseSentence) (Sentence, error) { return newPGRME(s) },
+		TypePGRMZ:   func(s BaseSentence) (Sentence, error) { return newPGRMZ(s) },
+		TypePMTK001: func(s BaseSentence) (Sentence, error) { return newPMTK001(s) },
+		TypePUBX:    newPUBX,
+	}
+	encapsulatedParsers = map[string]parserFunc{
+		TypeVDM: func(s BaseSentence) (Sentence, error) { return newVDMVDO(s) },
+		TypeVDO: func(s BaseSentence) (Sentence, error) { return newVDMVDO(s) },
+	}
+}
+
+// SupportedTypes returns the sorted data types (as returned by DataType)
+// of the sentences Parse can decode. Proprietary types are listed as their
+// manufacturer mnemonic followed by their data type, e.g. "GRME" for $PGRME
+// sentences.
+func SupportedTypes() []string {
+	types := []string{TypePMTK}
+	for _, m := range []map[string]parserFunc{parsers, proprietaryParsers, encapsulatedParsers, registeredParsers} {
+		for typ := range m {
+			types = append(types, typ)
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// RegisterParser registers the constructor of a sentence type the package
+// does not support. Proprietary types are given as their manufacturer mnemonic
+// followed by their data type, e.g. "MYCO" for $PMYCO sentences. Parse calls
+// it for sentences of that type from any talker. An error is returned if
+// the type is supported by the package or has already been registered.
+// It is not safe to call concurrently with parsing and is meant to be called
+// during initialization.
+func RegisterParser(typ string, fn func(BaseSentence) (Sentence, error)) error {
+	for _, t := range SupportedTypes() {
+		if t == typ {
+			return fmt.Errorf("nmea: parser for type %q already registered", typ)
+		}
+	}
+	registeredParsers[typ] = fn
+	return nil
+}
+
+// ParseOptions changes how ParseWithOptions decodes sentences.
+// The zero value parses like Parse.
+type ParseOptions struct {
+	// LocaleTolerant rejoins a GGA altitude that a misbehaving device wrote
+	// with a thousands separator (e.g. 1,234.5), which splits it into two
+	// fields. Such sentences are rejected with an explicit error otherwise.
+	LocaleTolerant bool
+}
+
 // Parse parses the given string into the correct sentence type.
 func Parse(raw string) (Sentence, error) {
+	m, _, err := parseTraced(raw, ParseOptions{})
+	return m, err
+}
+
+// ParseWithOptions is like Parse but decodes the sentence according to opts.
+func ParseWithOptions(raw string, opts ParseOptions) (Sentence, error) {
+	m, _, err := parseTraced(raw, opts)
+	return m, err
+}
+
+// ParseTraced is like Parse but also returns the name of the data type
+// whose constructor handled the sentence (e.g. "GGA"). The name is "base"
+// when the sentence envelope is valid but its type is not supported, and
+// empty when the envelope itself could not be parsed.
+func ParseTraced(raw string) (Sentence, string, error) {
+	return parseTraced(raw, ParseOptions{})
+}
+
+func parseTraced(raw string, opts ParseOptions) (Sentence, string, error) {
 	s, err := ParseSentence(raw)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	if err := checkMinFields(s); err != nil {
+		return nil, s.Type, err
 	}
-	if strings.HasPrefix(s.Raw, SentenceStart) {
-		switch s.Type {
-		case TypeALC:
-			return newALC(s)
-		case TypeALF:
-			return newALF(s)
-		case TypeALR:
-			return newALR(s)
-		case TypeARC:
-			return newARC(s)
-		case TypeDBK:
-			return newDBK(s)
-		case TypeDBS:
-			return newDBS(s)
-		case TypeDBT:
-			return newDBT(s)
-		case TypeDPT:
-			return newDPT(s)
-		case TypeHBT:
-			return newHBT(s)
-		case TypeHDG:
-			return newHDG(s)
-		case TypeRMC:
-			return newRMC(s)
-		case TypeROT:
-			return newROT(s)
-		case TypeGGA:
-			return newGGA(s)
-		case TypeGSA:
-			return newGSA(s)
-		case TypeGLL:
-			return newGLL(s)
-		case TypeVTG:
-			return newVTG(s)
-		case TypeZDA:
-			return newZDA(s)
-		case TypePGRME:
-			return newPGRME(s)
-		case TypeGSV:
-			return newGSV(s)
-		case TypeHDT:
-			return newHDT(s)
-		case TypeGNS:
-			return newGNS(s)
-		case TypeTHS:
-			return newTHS(s)
-		case TypeWPL:
-			return newWPL(s)
-		case TypeRTE:
-			return newRTE(s)
-		case TypeVHW:
-			return newVHW(s)
-		}
-	}
-	if strings.HasPrefix(s.Raw, SentenceStartEncapsulated) {
-		switch s.Type {
-		case TypeVDM, TypeVDO:
-			return newVDMVDO(s)
-		}
-	}
-	return nil, fmt.Errorf("nmea: sentence prefix '%s' not supported", s.Prefix())
+	parse, name := lookupParser(s, opts)
+	if parse == nil {
+		return nil, "base", fmt.Errorf("nmea: sentence prefix '%s' not supported", s.Prefix())
+	}
+	m, err := parse(s)
+	return m, name, err
 }
+
+// lookupParser returns the constructor for the sentence along with the name
+// of its data type, or nil if the type is not supported.
+func lookupParser(s BaseSentence, opts ParseOptions) (parserFunc, string) {
+	if s.Proprietary {
+		if parse, ok := proprietaryParsers[s.key()]; ok {
+			return parse, s.key()
+		}
+		if s.Talker == TypePMTK {
+			return parsePMTK, TypePMTK
+		}
+	}
+	if strings.HasPrefix(s.Raw, SentenceStart) && !s.Proprietary {
+		if s.Type == TypeGGA && opts.LocaleTolerant {
+			return parseGGALocaleTolerant, TypeGGA
+		}
+		if parse, ok := parsers[s.Type]; ok {
+			return parse, s.Type
+		}
+	}
+	if s.Encapsulated() {
+		if parse, ok := encapsulatedParsers[s.Type]; ok {
+			return parse, s.Type
+		}
+	}
+	if parse, ok := registeredParsers[s.key()]; ok {
+		return parse, s.key()
+	}
+	return nil, ""
+}
+
+func parsePMTK(s BaseSentence) (Sentence, error) { return newPMTK(s) }
+
+func parseGGALocaleTolerant(s BaseSentence) (Sentence, error) { return newGGA(s, true) }
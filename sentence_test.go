@@ -1,6 +1,9 @@
 package nmea
 
 import (
+	"encoding/json"
+	"errors"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -29,6 +32,20 @@ var sentencetests = []struct {
 			Raw:      "$GPFOO,1,2,3.3,x,y,zz,*51",
 		},
 	},
+	{
+		name:     "checksum separator in field",
+		raw:      "$GPFOO,wind*gust,1*4B",
+		datatype: "FOO",
+		talkerid: "GP",
+		prefix:   "GPFOO",
+		sent: BaseSentence{
+			Talker:   "GP",
+			Type:     "FOO",
+			Fields:   []string{"wind*gust", "1"},
+			Checksum: "4B",
+			Raw:      "$GPFOO,wind*gust,1*4B",
+		},
+	},
 	{
 		name:     "good parsing",
 		raw:      "$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0C",
@@ -43,6 +60,36 @@ var sentencetests = []struct {
 			Raw:      "$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0C",
 		},
 	},
+	{
+		name:     "proprietary sentence",
+		raw:      "$PGRME,3.3,M,4.9,M,6.0,M*25",
+		datatype: "E",
+		talkerid: "GRM",
+		prefix:   "PGRME",
+		sent: BaseSentence{
+			Talker:      "GRM",
+			Type:        "E",
+			Fields:      []string{"3.3", "M", "4.9", "M", "6.0", "M"},
+			Checksum:    "25",
+			Raw:         "$PGRME,3.3,M,4.9,M,6.0,M*25",
+			Proprietary: true,
+		},
+	},
+	{
+		name:     "proprietary sentence with longer type",
+		raw:      "$PMGNST,02.12,3,T,534,05.0,+03327,00*40",
+		datatype: "ST",
+		talkerid: "MGN",
+		prefix:   "PMGNST",
+		sent: BaseSentence{
+			Talker:      "MGN",
+			Type:        "ST",
+			Fields:      []string{"02.12", "3", "T", "534", "05.0", "+03327", "00"},
+			Checksum:    "40",
+			Raw:         "$PMGNST,02.12,3,T,534,05.0,+03327,00*40",
+			Proprietary: true,
+		},
+	},
 	{
 		name: "checksum bad",
 		raw:  "$GPFOO,1,2,3.4,x,y,zz,*51",
@@ -68,11 +115,26 @@ var sentencetests = []struct {
 		raw:  "GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0C",
 		err:  "nmea: sentence does not start with a '$' or '!'",
 	},
+	{
+		name: "proprietary without type",
+		raw:  "$P,1,2*53",
+		err:  "nmea: proprietary sentence has no type",
+	},
 	{
 		name: "another bad checksum",
 		raw:  "$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0A",
 		err:  "nmea: sentence checksum mismatch [0C != 0A]",
 	},
+	{
+		name: "truncated checksum",
+		raw:  "$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0",
+		err:  "nmea: sentence checksum is truncated",
+	},
+	{
+		name: "empty checksum",
+		raw:  "$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*",
+		err:  "nmea: sentence checksum is truncated",
+	},
 }
 
 func TestSentences(t *testing.T) {
@@ -88,16 +150,18 @@ func TestSentences(t *testing.T) {
 				assert.Equal(t, tt.datatype, sent.DataType())
 				assert.Equal(t, tt.talkerid, sent.TalkerID())
 				assert.Equal(t, tt.prefix, sent.Prefix())
+				assert.Equal(t, len(tt.sent.Fields), sent.FieldCount())
 			}
 		})
 	}
 }
 
 var prefixtests = []struct {
-	name   string
-	prefix string
-	talker string
-	typ    string
+	name        string
+	prefix      string
+	talker      string
+	typ         string
+	proprietary bool
 }{
 	{
 		name:   "normal prefix",
@@ -118,29 +182,108 @@ var prefixtests = []struct {
 		typ:    "",
 	},
 	{
-		name:   "proprietary talker",
-		prefix: "PGRME",
-		talker: "P",
-		typ:    "GRME",
+		name:        "proprietary talker",
+		prefix:      "PGRME",
+		talker:      "GRM",
+		typ:         "E",
+		proprietary: true,
+	},
+	{
+		name:        "proprietary talker with longer type",
+		prefix:      "PMGNST",
+		talker:      "MGN",
+		typ:         "ST",
+		proprietary: true,
+	},
+	{
+		name:        "proprietary talker without type",
+		prefix:      "PUBX",
+		talker:      "UBX",
+		typ:         "",
+		proprietary: true,
 	},
 	{
-		name:   "short proprietary talker",
-		prefix: "PX",
-		talker: "P",
-		typ:    "X",
+		name:        "short proprietary talker",
+		prefix:      "PX",
+		talker:      "X",
+		typ:         "",
+		proprietary: true,
 	},
 }
 
 func TestPrefix(t *testing.T) {
 	for _, tt := range prefixtests {
 		t.Run(tt.name, func(t *testing.T) {
-			talker, typ := parsePrefix(tt.prefix)
+			talker, typ, proprietary := parsePrefix(tt.prefix)
 			assert.Equal(t, tt.talker, talker)
 			assert.Equal(t, tt.typ, typ)
+			assert.Equal(t, tt.proprietary, proprietary)
 		})
 	}
 }
 
+func TestRegisterTalker(t *testing.T) {
+	RegisterTalker("XYZ")
+	defer delete(threeLetterTalkers, "XYZ")
+
+	tests := []struct {
+		prefix string
+		talker string
+		typ    string
+	}{
+		{"GPGGA", "GP", "GGA"},
+		{"XYZGGA", "XYZ", "GGA"},
+		{"XYGGA", "XY", "GGA"},
+		{"XYZ", "XY", "Z"},
+		{"XYZFOO", "XY", "ZFOO"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			talker, typ, _ := parsePrefix(tt.prefix)
+			assert.Equal(t, tt.talker, talker)
+			assert.Equal(t, tt.typ, typ)
+		})
+	}
+
+	m, err := Parse("$XYZGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*1D")
+	assert.NoError(t, err)
+	assert.Equal(t, "XYZ", m.TalkerID())
+	assert.Equal(t, TypeGGA, m.DataType())
+
+	assert.Panics(t, func() { RegisterTalker("XY") })
+}
+
+func TestRegisterTalkerOverlappingAIS(t *testing.T) {
+	RegisterTalker("AIV")
+	defer delete(threeLetterTalkers, "AIV")
+
+	m, err := Parse("!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55")
+	assert.NoError(t, err)
+	assert.Equal(t, "AI", m.TalkerID())
+	assert.Equal(t, TypeVDM, m.DataType())
+
+	m, err = Parse("!AIVDO,1,1,,,B>qc:003wk?8mP=18D3Q3wgTiT;T,0*13")
+	assert.NoError(t, err)
+	assert.Equal(t, "AI", m.TalkerID())
+	assert.Equal(t, TypeVDO, m.DataType())
+}
+
+func TestMinFields(t *testing.T) {
+	_, err := Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7*78")
+	assert.EqualError(t, err, "nmea: GPGGA has 8 fields, want at least 14")
+
+	_, err = Parse("$GPHBT,60.0,A,1*0D")
+	assert.NoError(t, err)
+
+	RegisterMinFields(TypeHBT, 4)
+	_, err = Parse("$GPHBT,60.0,A,1*0D")
+	assert.EqualError(t, err, "nmea: GPHBT has 3 fields, want at least 4")
+
+	RegisterMinFields(TypeHBT, 0)
+	_, err = Parse("$GPHBT,60.0,A,1*0D")
+	assert.NoError(t, err)
+}
+
 var parsetests = []struct {
 	name string
 	raw  string
@@ -162,6 +305,16 @@ var parsetests = []struct {
 		raw:  "!INVALID,1,2,*7E",
 		err:  "nmea: sentence prefix 'INVALID' not supported",
 	},
+	{
+		name: "standard type with encapsulation start",
+		raw:  "!INTHS,123.456,A*20",
+		err:  "nmea: sentence prefix 'INTHS' not supported",
+	},
+	{
+		name: "encapsulated type with standard start",
+		raw:  "$AIVDM,1,1,,1,,0*56",
+		err:  "nmea: sentence prefix 'AIVDM' not supported",
+	},
 }
 
 func TestParse(t *testing.T) {
@@ -177,3 +330,395 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+var dispatchtests = []struct {
+	raw string
+	typ interface{}
+}{
+	{"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51", GGA{}},
+	{"$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0C", RMC{}},
+	{"$INTHS,123.456,A*20", THS{}},
+	{"$PGRME,3.3,M,4.9,M,6.0,M*25", PGRME{}},
+	{"$PMTK001,604,3*32", PMTK001{}},
+	{"$PMTK220,1000*1F", PMTK{}},
+	{"!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55", VDMVDO{}},
+}
+
+func TestParseDispatch(t *testing.T) {
+	for _, tt := range dispatchtests {
+		t.Run(tt.raw, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			assert.NoError(t, err)
+			assert.IsType(t, tt.typ, m)
+		})
+	}
+}
+
+func TestParseTraced(t *testing.T) {
+	tests := []struct {
+		raw   string
+		trace string
+		err   string
+	}{
+		{"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51", "GGA", ""},
+		{"$PGRME,3.3,M,4.9,M,6.0,M*25", "GRME", ""},
+		{"$PMTK220,1000*1F", "MTK", ""},
+		{"!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55", "VDM", ""},
+		{"$GPFOO,1,2,3.3,x,y,zz,*51", "base", "nmea: sentence prefix 'GPFOO' not supported"},
+		{"$PMGNST,02.12,3,T,534,05.0,+03327,00*40", "base", "nmea: sentence prefix 'PMGNST' not supported"},
+		{"$GPGGA,1,2*00", "", "nmea: sentence checksum mismatch [55 != 00]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			_, trace, err := ParseTraced(tt.raw)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.trace, trace)
+		})
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, tt := range dispatchtests {
+			if _, err := Parse(tt.raw); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkDispatch measures the constructor lookup alone,
+// without parsing the sentence envelope or its fields.
+func BenchmarkDispatch(b *testing.B) {
+	sentences := make([]BaseSentence, len(dispatchtests))
+	for i, tt := range dispatchtests {
+		s, err := ParseSentence(tt.raw)
+		if err != nil {
+			b.Fatal(err)
+		}
+		sentences[i] = s
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, s := range sentences {
+			if parse, _ := lookupParser(s, ParseOptions{}); parse == nil {
+				b.Fatal(s.Prefix())
+			}
+		}
+	}
+}
+
+func TestWithTalker(t *testing.T) {
+	s, err := ParseSentence("$IIHDT,123.456,T*25")
+	assert.NoError(t, err)
+	gp := s.WithTalker("GP")
+	assert.Equal(t, BaseSentence{
+		Talker:   "GP",
+		Type:     "HDT",
+		Fields:   []string{"123.456", "T"},
+		Checksum: "32",
+		Raw:      "$GPHDT,123.456,T*32",
+	}, gp)
+	assert.Equal(t, "II", s.Talker)
+	_, err = ParseSentence(gp.Raw)
+	assert.NoError(t, err)
+
+	s, err = ParseSentence("!AIVDM,1,1,,1,,0*56")
+	assert.NoError(t, err)
+	assert.Equal(t, "!BSVDM,1,1,,1,,0*4F", s.WithTalker("BS").Raw)
+}
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{
+			name: "clean sentence",
+			raw:  "$GPHDT,123.456,T*32",
+			want: "$GPHDT,123.456,T*32",
+		},
+		{
+			name: "embedded null bytes",
+			raw:  "$GPHDT,12\x003.456,\x00T*32",
+			want: "$GPHDT,123.456,T*32",
+		},
+		{
+			name: "trailing control characters",
+			raw:  "$GPHDT,123.456,T*32\r\n\x03",
+			want: "$GPHDT,123.456,T*32",
+		},
+		{
+			name: "non ascii bytes",
+			raw:  "$GPHDT,123.456,\xffT*32",
+			want: "$GPHDT,123.456,T*32",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := Sanitize(tt.raw)
+			assert.Equal(t, tt.want, raw)
+			_, err := ParseSentence(raw)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTrimToStart(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{
+			name: "clean sentence",
+			raw:  "$GPHDT,123.456,T*32",
+			want: "$GPHDT,123.456,T*32",
+		},
+		{
+			name: "leading noise",
+			raw:  "xxxx$GPHDT,123.456,T*32",
+			want: "$GPHDT,123.456,T*32",
+		},
+		{
+			name: "leading binary noise",
+			raw:  "\x00\xfe\x13*!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55",
+			want: "!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55",
+		},
+		{
+			name: "leading noise before tag block",
+			raw:  `xx\g:1-2-1234*5A\$INTHS,123.456,A*20`,
+			want: `\g:1-2-1234*5A\$INTHS,123.456,A*20`,
+		},
+		{
+			name: "malformed tag block",
+			raw:  `\g:1-2-1234*00\$INTHS,123.456,A*20`,
+			want: "$INTHS,123.456,A*20",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := TrimToStart(tt.raw)
+			assert.Equal(t, tt.want, raw)
+			_, err := ParseSentence(raw)
+			assert.NoError(t, err)
+		})
+	}
+	assert.Equal(t, "no start token", TrimToStart("no start token"))
+}
+
+func TestPeekType(t *testing.T) {
+	for _, raw := range []string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0C",
+		"$PGRME,3.3,M,4.9,M,6.0,M*25",
+		"$PMTK001,604,3*32",
+		"!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55",
+		`\g:1-2-1234*5A\$INTHS,123.456,A*20`,
+	} {
+		t.Run(raw, func(t *testing.T) {
+			s, err := ParseSentence(raw)
+			assert.NoError(t, err)
+			talker, typ, ok := PeekType(raw)
+			assert.True(t, ok)
+			assert.Equal(t, s.Talker, talker)
+			assert.Equal(t, s.Type, typ)
+		})
+	}
+	for _, raw := range []string{
+		"",
+		"GPGGA,1,2*55",
+		"$",
+		"$,1,2*55",
+		"$GP,1*55",
+		"$GPGGA",
+		`\g:1-2-1234*00\$INTHS,123.456,A*20`,
+		`\g:1-2-1234*5A$INTHS,123.456,A*20`,
+	} {
+		t.Run(raw, func(t *testing.T) {
+			talker, typ, ok := PeekType(raw)
+			assert.False(t, ok)
+			assert.Empty(t, talker)
+			assert.Empty(t, typ)
+		})
+	}
+}
+
+type unrenderableSentence struct{}
+
+func (unrenderableSentence) String() string                         { return "" }
+func (unrenderableSentence) Prefix() string                         { return "" }
+func (unrenderableSentence) DataType() string                       { return "" }
+func (unrenderableSentence) TalkerID() string                       { return "" }
+func (unrenderableSentence) FieldCount() int                        { return 0 }
+func (unrenderableSentence) ToMap() (map[string]interface{}, error) { return nil, nil }
+
+func TestRender(t *testing.T) {
+	for _, raw := range []string{
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+		"$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0C",
+		"$INTHS,123.456,A*20",
+		"$PGRME,3.3,M,4.9,M,6.0,M*25",
+		"!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55",
+	} {
+		t.Run(raw, func(t *testing.T) {
+			m, err := Parse(raw)
+			assert.NoError(t, err)
+			rendered, err := Render(m)
+			assert.NoError(t, err)
+			assert.Equal(t, raw, rendered)
+			again, err := Parse(rendered)
+			assert.NoError(t, err)
+			assert.Equal(t, m, again)
+		})
+	}
+
+	_, err := Render(unrenderableSentence{})
+	assert.EqualError(t, err, "nmea: cannot render sentence of type nmea.unrenderableSentence")
+}
+
+func TestSupportedTypes(t *testing.T) {
+	types := SupportedTypes()
+	for _, typ := range []string{TypeGGA, TypeRMC, TypeTXT, TypePGRME, TypePMTK, TypePMTK001, TypePUBX, TypeVDM, TypeVDO} {
+		assert.Contains(t, types, typ)
+	}
+	assert.True(t, sort.StringsAreSorted(types))
+	assert.NotContains(t, types, "FOO")
+
+	assert.NoError(t, RegisterParser("FOO", func(s BaseSentence) (Sentence, error) { return nil, nil }))
+	defer delete(registeredParsers, "FOO")
+	assert.Contains(t, SupportedTypes(), "FOO")
+}
+
+// mycoSentence is a user defined proprietary sentence.
+type mycoSentence struct {
+	BaseSentence
+	Value int64
+}
+
+func (s mycoSentence) ToMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"value": s.Value}, nil
+}
+
+func TestRegisterParser(t *testing.T) {
+	_, err := Parse("$PMYCO,42*62")
+	assert.EqualError(t, err, "nmea: sentence prefix 'PMYCO' not supported")
+
+	err = RegisterParser("MYCO", func(s BaseSentence) (Sentence, error) {
+		p := NewParser(s)
+		return mycoSentence{BaseSentence: s, Value: p.Int64(0, "value")}, p.Err()
+	})
+	assert.NoError(t, err)
+	defer delete(registeredParsers, "MYCO")
+
+	m, trace, err := ParseTraced("$PMYCO,42*62")
+	assert.NoError(t, err)
+	assert.Equal(t, "MYCO", trace)
+	assert.Equal(t, int64(42), m.(mycoSentence).Value)
+
+	err = RegisterParser("MYCO", func(s BaseSentence) (Sentence, error) { return nil, nil })
+	assert.EqualError(t, err, `nmea: parser for type "MYCO" already registered`)
+	err = RegisterParser(TypeGGA, func(s BaseSentence) (Sentence, error) { return nil, nil })
+	assert.EqualError(t, err, `nmea: parser for type "GGA" already registered`)
+	for _, typ := range SupportedTypes() {
+		assert.Error(t, RegisterParser(typ, func(s BaseSentence) (Sentence, error) { return nil, nil }), typ)
+	}
+	_, ok := registeredParsers[TypePMTK]
+	assert.False(t, ok)
+}
+
+func TestToJSON(t *testing.T) {
+	m, err := Parse("$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0C")
+	assert.NoError(t, err)
+	b, err := ToJSON(m)
+	assert.NoError(t, err)
+
+	var got map[string]interface{}
+	assert.NoError(t, json.Unmarshal(b, &got))
+	want, err := m.ToMap()
+	assert.NoError(t, err)
+	assert.Len(t, got, len(want))
+	assert.Equal(t, "23:52:36.0000", got["time"])
+	assert.Equal(t, "25/09/05", got["date"])
+	assert.Equal(t, 44.7, got["speed"])
+	assert.Equal(t, "GP", got["talker"])
+	assert.Equal(t, "RMC", got["type"])
+	assert.Equal(t, "0C", got["checksum"])
+	assert.Equal(t, "$GPRMC,235236,A,3925.9479,N,11945.9211,W,44.7,153.6,250905,15.2,E,A*0C", got["raw"])
+}
+
+func TestEncapsulated(t *testing.T) {
+	s, err := ParseSentence("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51")
+	assert.NoError(t, err)
+	assert.False(t, s.Encapsulated())
+
+	s, err = ParseSentence("!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55")
+	assert.NoError(t, err)
+	assert.True(t, s.Encapsulated())
+}
+
+func TestEncode(t *testing.T) {
+	raw, err := Encode([]string{"GPHBT", "60.0", "A", "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "$GPHBT,60.0,A,1*0D", raw)
+
+	_, err = Encode(nil)
+	assert.EqualError(t, err, "nmea: cannot encode sentence without prefix")
+	_, err = Encode([]string{"GPHBT", "60.0", "A*"})
+	assert.EqualError(t, err, `nmea: cannot encode field "A*"`)
+	_, err = Encode([]string{"GPHBT", "60,0"})
+	assert.EqualError(t, err, `nmea: cannot encode field "60,0"`)
+}
+
+func TestChecksumError(t *testing.T) {
+	_, err := Parse("$GPFOO,1,2,3.3,x,y,zz,*52")
+	var checksumErr ChecksumError
+	assert.True(t, errors.As(err, &checksumErr))
+	assert.Equal(t, ChecksumError{Expected: "51", Received: "52"}, checksumErr)
+	assert.EqualError(t, err, "nmea: sentence checksum mismatch [51 != 52]")
+
+	_, err = Parse("$GPFOO,1,2,3.3,x,y,zz,*51")
+	assert.False(t, errors.As(err, &checksumErr))
+}
+
+func TestParseSentenceLenient(t *testing.T) {
+	s, err := ParseSentenceLenient("$GPFOO,1,2,3.3,x,y,zz,")
+	assert.NoError(t, err)
+	assert.Equal(t, BaseSentence{
+		Talker: "GP",
+		Type:   "FOO",
+		Fields: []string{"1", "2", "3.3", "x", "y", "zz", ""},
+		Raw:    "$GPFOO,1,2,3.3,x,y,zz,",
+	}, s)
+
+	s, err = ParseSentenceLenient("$GPFOO,1,2,3.3,x,y,zz,*51")
+	assert.NoError(t, err)
+	assert.Equal(t, "51", s.Checksum)
+
+	_, err = ParseSentenceLenient("$GPFOO,1,2,3.3,x,y,zz,*52")
+	assert.EqualError(t, err, "nmea: sentence checksum mismatch [51 != 52]")
+
+	_, err = ParseSentence("$GPFOO,1,2,3.3,x,y,zz,")
+	assert.EqualError(t, err, "nmea: sentence does not contain checksum separator")
+}
+
+func TestFieldPresent(t *testing.T) {
+	s := BaseSentence{Fields: []string{"1", "", "3"}}
+	assert.True(t, s.FieldPresent(0))
+	assert.False(t, s.FieldPresent(1))
+	assert.True(t, s.FieldPresent(2))
+	assert.False(t, s.FieldPresent(3))
+	assert.False(t, s.FieldPresent(-1))
+}
+
+func TestPopulatedFields(t *testing.T) {
+	s, err := ParseSentence("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,,M,,0000*4C")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 11, 13}, s.PopulatedFields())
+	assert.Equal(t, []int{}, BaseSentence{Fields: []string{"", ""}}.PopulatedFields())
+}
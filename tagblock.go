@@ -0,0 +1,81 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// TagBlockSep is the token delimiting a TAG block prepended to a sentence.
+	TagBlockSep = `\`
+)
+
+// TagBlock holds the IEC 61162-450 TAG block parameters prepended to a
+// sentence, e.g. \g:1-2-73874,n:157036,s:r003669945,c:1241544035*4A\
+// https://gpsd.gitlab.io/gpsd/AIVDM.html#_nmea_tag_blocks
+type TagBlock struct {
+	Time         int64  // Unix timestamp, parameter c
+	RelativeTime int64  // Relative time, parameter r
+	Destination  string // Destination identification, parameter d
+	Grouping     string // Sentence grouping, parameter g
+	LineCount    int64  // Line count, parameter n
+	Source       string // Source identification, parameter s
+	Text         string // Text string, parameter t
+}
+
+// parseTagBlock parses the content between the TAG block delimiters.
+func parseTagBlock(tags string) (TagBlock, error) {
+	sumSepIndex := strings.LastIndex(tags, ChecksumSep)
+	if sumSepIndex == -1 {
+		return TagBlock{}, fmt.Errorf("nmea: tag block does not contain checksum separator")
+	}
+	var (
+		fieldsRaw   = tags[:sumSepIndex]
+		checksumRaw = strings.ToUpper(tags[sumSepIndex+1:])
+		checksum    = xorChecksum(fieldsRaw)
+		tagBlock    TagBlock
+		err         error
+	)
+	if checksum != checksumRaw {
+		return TagBlock{}, fmt.Errorf("nmea: tag block checksum mismatch [%s != %s]", checksum, checksumRaw)
+	}
+	for _, field := range strings.Split(fieldsRaw, FieldSep) {
+		parts := strings.SplitN(field, ":", 2)
+		if len(parts) != 2 {
+			return TagBlock{}, fmt.Errorf("nmea: tag block invalid field: %s", field)
+		}
+		key, value := parts[0], parts[1]
+		switch key {
+		case "c":
+			tagBlock.Time, err = strconv.ParseInt(value, 10, 64)
+		case "r":
+			tagBlock.RelativeTime, err = strconv.ParseInt(value, 10, 64)
+		case "d":
+			tagBlock.Destination = value
+		case "g":
+			tagBlock.Grouping = value
+		case "n":
+			tagBlock.LineCount, err = strconv.ParseInt(value, 10, 64)
+		case "s":
+			tagBlock.Source = value
+		case "t":
+			tagBlock.Text = value
+		default:
+			return TagBlock{}, fmt.Errorf("nmea: tag block unknown parameter: %s", key)
+		}
+		if err != nil {
+			return TagBlock{}, fmt.Errorf("nmea: tag block invalid %s: %s", key, value)
+		}
+	}
+	return tagBlock, nil
+}
+
+// StampNow prepends a TAG block holding the current Unix timestamp
+// (parameter c) to a sentence without a TAG block, e.g. for recording
+// a live feed.
+func StampNow(raw string) string {
+	tags := "c:" + strconv.FormatInt(time.Now().Unix(), 10)
+	return TagBlockSep + tags + ChecksumSep + xorChecksum(tags) + TagBlockSep + raw
+}
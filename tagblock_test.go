@@ -0,0 +1,112 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var tagblocktests = []struct {
+	name     string
+	raw      string
+	err      string
+	tagBlock TagBlock
+	sentence string
+}{
+	{
+		name: "group, line count, source and time",
+		raw:  `\g:1-2-73874,n:157036,s:r003669945,c:1241544035*4A\!AIVDM,1,1,,B,15N4cJ` + "`" + `005Jrek0H@9n` + "`" + `DW5608EP,0*13`,
+		tagBlock: TagBlock{
+			Time:      1241544035,
+			Grouping:  "1-2-73874",
+			LineCount: 157036,
+			Source:    "r003669945",
+		},
+		sentence: "!AIVDM,1,1,,B,15N4cJ`005Jrek0H@9n`DW5608EP,0*13",
+	},
+	{
+		name: "source and time",
+		raw:  `\s:2573485,c:1671620143*05\$INTHS,123.456,A*20`,
+		tagBlock: TagBlock{
+			Time:   1671620143,
+			Source: "2573485",
+		},
+		sentence: "$INTHS,123.456,A*20",
+	},
+	{
+		name: "separator in text",
+		raw:  `\t:wind*gust,s:r003669945*40\$INTHS,123.456,A*20`,
+		tagBlock: TagBlock{
+			Source: "r003669945",
+			Text:   "wind*gust",
+		},
+		sentence: "$INTHS,123.456,A*20",
+	},
+	{
+		name: "sentence checksum mismatch",
+		raw:  `\s:r003669945,c:1241544035*79\$INTHS,123.456,A*21`,
+		err:  "nmea: sentence checksum mismatch [20 != 21]",
+	},
+	{
+		name: "tag block checksum mismatch with valid sentence",
+		raw:  `\s:r003669945,c:1241544035*20\$INTHS,123.456,A*20`,
+		err:  "nmea: tag block checksum mismatch [79 != 20]",
+	},
+	{
+		name:     "no tag block",
+		raw:      "$INTHS,123.456,A*20",
+		sentence: "$INTHS,123.456,A*20",
+	},
+	{
+		name: "checksum mismatch",
+		raw:  `\s:2573485,c:1671620143*06\$INTHS,123.456,A*20`,
+		err:  "nmea: tag block checksum mismatch [05 != 06]",
+	},
+	{
+		name: "missing checksum",
+		raw:  `\s:2573485,c:1671620143\$INTHS,123.456,A*20`,
+		err:  "nmea: tag block does not contain checksum separator",
+	},
+	{
+		name: "not terminated",
+		raw:  `\s:2573485,c:1671620143*05$INTHS,123.456,A*20`,
+		err:  "nmea: tag block is not terminated",
+	},
+	{
+		name: "invalid time",
+		raw:  `\c:abc*39\$INTHS,123.456,A*20`,
+		err:  "nmea: tag block invalid c: abc",
+	},
+	{
+		name: "unknown parameter",
+		raw:  `\z:1*71\$INTHS,123.456,A*20`,
+		err:  "nmea: tag block unknown parameter: z",
+	},
+}
+
+func TestTagBlock(t *testing.T) {
+	for _, tt := range tagblocktests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseSentence(tt.raw)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.tagBlock, s.TagBlock)
+				assert.Equal(t, tt.sentence, s.Raw)
+			}
+		})
+	}
+}
+
+func TestStampNow(t *testing.T) {
+	before := time.Now().Unix()
+	raw := StampNow("$INTHS,123.456,A*20")
+	after := time.Now().Unix()
+
+	s, err := ParseSentence(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "$INTHS,123.456,A*20", s.Raw)
+	assert.True(t, s.TagBlock.Time >= before && s.TagBlock.Time <= after, "timestamp %d not in [%d, %d]", s.TagBlock.Time, before, after)
+}
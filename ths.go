@@ -38,6 +38,23 @@ func (s THS) ToMap() (map[string]interface{}, error) {
 	return m, nil
 }
 
+// Valid reports whether the heading can be used. Autonomous, estimated,
+// manual and simulated headings are valid, invalid (standby) headings and
+// sentences without a status are not. Simulated headings are valid so that
+// simulators can drive consumers, use IsSimulated to flag them.
+func (s THS) Valid() bool {
+	switch s.Status {
+	case AutonomousTHS, EstimatedTHS, ManualTHS, SimulatorTHS:
+		return true
+	}
+	return false
+}
+
+// IsSimulated reports whether the heading comes from a simulator.
+func (s THS) IsSimulated() bool {
+	return s.Status == SimulatorTHS
+}
+
 // newTHS constructor
 func newTHS(s BaseSentence) (THS, error) {
 	p := NewParser(s)
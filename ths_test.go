@@ -80,3 +80,25 @@ func TestTHS(t *testing.T) {
 		})
 	}
 }
+
+func TestTHSValid(t *testing.T) {
+	tests := []struct {
+		status    string
+		valid     bool
+		simulated bool
+	}{
+		{AutonomousTHS, true, false},
+		{EstimatedTHS, true, false},
+		{ManualTHS, true, false},
+		{SimulatorTHS, true, true},
+		{InvalidTHS, false, false},
+		{"", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			ths := THS{Heading: 123.456, Status: tt.status}
+			assert.Equal(t, tt.valid, ths.Valid())
+			assert.Equal(t, tt.simulated, ths.IsSimulated())
+		})
+	}
+}
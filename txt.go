@@ -0,0 +1,49 @@
+package nmea
+
+import "strings"
+
+const (
+	// TypeTXT type for TXT sentences
+	TypeTXT = "TXT"
+)
+
+// TXT is a text transmission, used by receivers such as u-blox ones
+// to report startup and status messages.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_txt_text_transmission
+type TXT struct {
+	BaseSentence
+	TotalNumber int64  // Total number of sentences of the message
+	Number      int64  // Sentence number
+	Identifier  int64  // Text identifier, e.g. 00 = error, 01 = warning, 02 = notice
+	Message     string // Text message, including any commas it contains
+}
+
+func (s TXT) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"total_number": s.TotalNumber,
+		"number":       s.Number,
+		"identifier":   s.Identifier,
+		"message":      s.Message,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newTXT constructor
+func newTXT(s BaseSentence) (TXT, error) {
+	p := NewParser(s)
+	p.AssertType(TypeTXT)
+	return TXT{
+		BaseSentence: s,
+		TotalNumber:  p.Int64(0, "total number of sentences"),
+		Number:       p.Int64(1, "sentence number"),
+		Identifier:   p.Int64(2, "identifier"),
+		Message:      strings.Join(p.ListString(3, "message"), FieldSep),
+	}, p.Err()
+}
@@ -0,0 +1,71 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var txttests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  TXT
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPTXT,01,01,02,u-blox ag - www.u-blox.com*50",
+		msg: TXT{
+			TotalNumber: 1,
+			Number:      1,
+			Identifier:  2,
+			Message:     "u-blox ag - www.u-blox.com",
+		},
+	},
+	{
+		name: "message with commas",
+		raw:  "$GPTXT,01,01,02,ANTSTATUS=OK, ANTPOWER=ON*0F",
+		msg: TXT{
+			TotalNumber: 1,
+			Number:      1,
+			Identifier:  2,
+			Message:     "ANTSTATUS=OK, ANTPOWER=ON",
+		},
+	},
+	{
+		name: "empty message",
+		raw:  "$GPTXT,01,01,02,*4D",
+		msg: TXT{
+			TotalNumber: 1,
+			Number:      1,
+			Identifier:  2,
+		},
+	},
+	{
+		name: "missing message",
+		raw:  "$GPTXT,01,01,02*61",
+		err:  "nmea: GPTXT invalid message: index out of range",
+	},
+	{
+		name: "bad identifier",
+		raw:  "$GPTXT,01,01,XX,hello*2D",
+		err:  "nmea: GPTXT invalid identifier: XX",
+	},
+}
+
+func TestTXT(t *testing.T) {
+	for _, tt := range txttests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				txt := m.(TXT)
+				txt.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, txt)
+			}
+		})
+	}
+}
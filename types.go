@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 )
 
@@ -31,6 +32,10 @@ const (
 	West = "W"
 )
 
+// CoordinatePrecision is the number of decimal places coordinates are
+// rounded to in ToMap output. The default of 6 is about 0.1 m.
+var CoordinatePrecision = 6
+
 // ParseLatLong parses the supplied string into the LatLong.
 //
 // Supported formats are:
@@ -38,12 +43,15 @@ const (
 // - Decimal (e.g. 33.23454)
 // - GPS (e.g 15113.4322S)
 //
+// A GPS coordinate with a hemisphere other than N, S, E or W is rejected.
 func ParseLatLong(s string) (float64, error) {
 	var l float64
 	if v, err := ParseDMS(s); err == nil {
 		l = v
 	} else if v, err := ParseGPS(s); err == nil {
 		l = v
+	} else if errors.Is(err, errInvalidHemisphere) {
+		return 0, err
 	} else if v, err := ParseDecimal(s); err == nil {
 		l = v
 	} else {
@@ -55,6 +63,47 @@ func ParseLatLong(s string) (float64, error) {
 	return l, nil
 }
 
+// ValidCoordinate reports whether the latitude is within ±90 degrees and the
+// longitude within ±180 degrees. ParseLatLong only checks the ±180 range
+// shared by both, so a corrupted latitude field can get past it.
+func ValidCoordinate(lat, lon float64) bool {
+	return lat >= -90 && lat <= 90 && lon >= -180 && lon <= 180
+}
+
+// Latitude is a latitude in signed decimal degrees, negative in the southern hemisphere.
+type Latitude float64
+
+// Valid reports whether the latitude is within ±90 degrees.
+func (l Latitude) Valid() bool {
+	return l >= -90 && l <= 90
+}
+
+// String formats the latitude in the NMEA ddmm.mmmm form followed by
+// its hemisphere, e.g. "4807.0380 N".
+func (l Latitude) String() string {
+	v, h := formatCanonicalGPS(float64(l), 2, North, South)
+	return v + " " + h
+}
+
+// Longitude is a longitude in signed decimal degrees, negative in the western hemisphere.
+type Longitude float64
+
+// Valid reports whether the longitude is within ±180 degrees.
+func (l Longitude) Valid() bool {
+	return l >= -180 && l <= 180
+}
+
+// String formats the longitude in the NMEA dddmm.mmmm form followed by
+// its hemisphere, e.g. "01131.0000 E".
+func (l Longitude) String() string {
+	v, h := formatCanonicalGPS(float64(l), 3, East, West)
+	return v + " " + h
+}
+
+// errInvalidHemisphere is returned for a GPS coordinate whose hemisphere
+// is not one of N, S, E or W.
+var errInvalidHemisphere = errors.New("invalid hemisphere")
+
 // ParseGPS parses a GPS/NMEA coordinate.
 // e.g 15113.4322S
 func ParseGPS(s string) (float64, error) {
@@ -77,7 +126,7 @@ func ParseGPS(s string) (float64, error) {
 	} else if dir == South || dir == West {
 		return 0 - value, nil
 	} else {
-		return 0, fmt.Errorf("invalid direction [%s]", dir)
+		return 0, fmt.Errorf("%w [%s]", errInvalidHemisphere, dir)
 	}
 }
 
@@ -92,6 +141,42 @@ func FormatGPS(l float64) string {
 	return fmt.Sprintf("%d%s%.4f", int(degrees), padding, fraction)
 }
 
+// formatCanonicalGPS formats a coordinate in the conventional fixed width
+// NMEA form, degrees padded to the given number of digits followed by
+// minutes with four decimals (e.g. 3356.4650 or 15124.5567), along with
+// its hemisphere (pos for positive values, neg for negative ones).
+func formatCanonicalGPS(l float64, degreeDigits int, pos, neg string) (string, string) {
+	hemisphere := pos
+	if l < 0 {
+		hemisphere = neg
+	}
+	// work in units of 1/10000 minute so rounding never yields 60 minutes
+	units := int64(round(math.Abs(l) * 60 * 10000))
+	degrees := units / (60 * 10000)
+	minutes := float64(units%(60*10000)) / 10000
+	return fmt.Sprintf("%0*d%07.4f", degreeDigits, degrees, minutes), hemisphere
+}
+
+// formatGPSAs formats the coordinate l into a position and hemisphere field.
+// The original fields pos and hemisphere are returned if they still hold l,
+// so a parsed coordinate is reproduced as received, and empty fields with a
+// zero l stay empty. Other values are formatted like formatCanonicalGPS.
+func formatGPSAs(l float64, pos, hemisphere string, degreeDigits int, posHemisphere, negHemisphere string) (string, string) {
+	if pos == "" && hemisphere == "" && l == 0 {
+		return "", ""
+	}
+	if v, err := ParseLatLong(pos + " " + hemisphere); err == nil && v == l {
+		return pos, hemisphere
+	}
+	return formatCanonicalGPS(l, degreeDigits, posHemisphere, negHemisphere)
+}
+
+// roundCoordinate rounds a decimal degree coordinate to CoordinatePrecision decimal places.
+func roundCoordinate(l float64) float64 {
+	scale := math.Pow(10, float64(CoordinatePrecision))
+	return round(l*scale) / scale
+}
+
 // ParseDecimal parses a decimal format coordinate.
 // e.g: 151.196019
 func ParseDecimal(s string) (float64, error) {
@@ -180,12 +265,39 @@ func (t Time) String() string {
 	return fmt.Sprintf("%02d:%02d:%07.4f", t.Hour, t.Minute, seconds)
 }
 
+// Before reports whether t is earlier in the day than other.
+// It returns false if either time is invalid.
+func (t Time) Before(other Time) bool {
+	return t.Valid && other.Valid && t.milliseconds() < other.milliseconds()
+}
+
+// After reports whether t is later in the day than other.
+// It returns false if either time is invalid.
+func (t Time) After(other Time) bool {
+	return t.Valid && other.Valid && t.milliseconds() > other.milliseconds()
+}
+
+// Equal reports whether t and other are the same time of day.
+// Two invalid times are equal, an invalid and a valid time are not.
+func (t Time) Equal(other Time) bool {
+	if !t.Valid || !other.Valid {
+		return t.Valid == other.Valid
+	}
+	return t.milliseconds() == other.milliseconds()
+}
+
+// milliseconds returns the number of milliseconds since midnight.
+func (t Time) milliseconds() int {
+	return ((t.Hour*60+t.Minute)*60+t.Second)*1000 + t.Millisecond
+}
+
 // timeRe is used to validate time strings
 var timeRe = regexp.MustCompile(`^\d{6}(\.\d*)?$`)
 
 // ParseTime parses wall clock time.
 // e.g. hhmmss.ssss
 // An empty time string will result in an invalid time.
+// Second 60 is accepted since GNSS receivers report it during a leap second.
 func ParseTime(s string) (Time, error) {
 	if s == "" {
 		return Time{}, nil
@@ -200,6 +312,42 @@ func ParseTime(s string) (Time, error) {
 	return Time{true, hour, minute, int(whole), int(round(frac * 1000))}, nil
 }
 
+// formatTime formats the time as hhmmss followed by the given number of
+// decimals of the seconds. An invalid time is formatted as an empty string.
+func formatTime(t Time, decimals int) string {
+	if !t.Valid {
+		return ""
+	}
+	hms := fmt.Sprintf("%02d%02d%02d", t.Hour, t.Minute, t.Second)
+	if decimals <= 0 {
+		return hms
+	}
+	frac := fmt.Sprintf("%03d", t.Millisecond)
+	for len(frac) < decimals {
+		frac += "0"
+	}
+	return hms + "." + frac[:decimals]
+}
+
+// formatTimeAs formats t with as many decimals as the time field value orig,
+// or with milliseconds if orig is empty and t has a fraction of a second.
+func formatTimeAs(t Time, orig string) string {
+	decimals := fieldDecimals(orig)
+	if orig == "" && t.Millisecond != 0 {
+		decimals = 3
+	}
+	return formatTime(t, decimals)
+}
+
+// fieldDecimals returns the number of decimals of a numeric field value
+// such as the seconds of a hhmmss.ss time field.
+func fieldDecimals(s string) int {
+	if i := strings.Index(s, "."); i != -1 {
+		return len(s) - i - 1
+	}
+	return 0
+}
+
 // round is implemented here because it wasn't added until go1.10
 // this code is taken directly from the math.Round documentation
 // TODO: use math.Round after a reasonable amount of time
@@ -244,5 +392,33 @@ func ParseDate(ddmmyy string) (Date, error) {
 	if err != nil {
 		return Date{}, errors.New(ddmmyy)
 	}
-	return Date{true, dd, mm, yy}, nil
+	if dd < 1 || dd > 31 || mm < 1 || mm > 12 || yy < 0 {
+		return Date{}, fmt.Errorf("parse date: out of range, got '%s'", ddmmyy)
+	}
+	d := Date{true, dd, mm, yy}
+	// time.Date normalizes days past the end of the month (e.g. 31 Feb)
+	if t := time.Date(d.Year(), time.Month(mm), dd, 0, 0, 0, 0, time.UTC); t.Day() != dd {
+		return Date{}, fmt.Errorf("parse date: out of range, got '%s'", ddmmyy)
+	}
+	return d, nil
+}
+
+// Year returns the four digit year of the date. Two digit years
+// are pivoted at 70: 70-99 map to 1970-1999 and 00-69 to 2000-2069.
+func (d Date) Year() int {
+	if d.YY >= 70 {
+		return 1900 + d.YY
+	}
+	return 2000 + d.YY
+}
+
+// dateTime combines a date and a time of day into a UTC timestamp.
+// The zero time.Time is returned if either of them is invalid.
+// A leap second (second 60) is normalized into the following second
+// since time.Time cannot represent it.
+func dateTime(d Date, t Time) time.Time {
+	if !d.Valid || !t.Valid {
+		return time.Time{}
+	}
+	return time.Date(d.Year(), time.Month(d.MM), d.DD, t.Hour, t.Minute, t.Second, t.Millisecond*int(time.Millisecond), time.UTC)
 }
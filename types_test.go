@@ -32,6 +32,44 @@ func TestParseLatLong(t *testing.T) {
 	}
 }
 
+func TestParseLatLongHemisphere(t *testing.T) {
+	var tests = []struct {
+		value string
+		err   string
+	}{
+		{"3345.1232 X", "invalid hemisphere [X]"},
+		{"3345.1232 n", "invalid hemisphere [n]"},
+		{"3345.1232 ", "invalid hemisphere []"},
+		{"X S", "cannot parse [X S], unknown format"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			_, err := ParseLatLong(tt.value)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestValidCoordinate(t *testing.T) {
+	var tests = []struct {
+		lat   float64
+		lon   float64
+		valid bool
+	}{
+		{0, 0, true},
+		{-33.941083, 151.409278, true},
+		{90, 180, true},
+		{-90, -180, true},
+		{90.5, 0, false},
+		{-151.76646, 33.752054, false},
+		{0, 180.1, false},
+		{0, -200, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.valid, ValidCoordinate(tt.lat, tt.lon), "lat %f lon %f", tt.lat, tt.lon)
+	}
+}
+
 func TestParseGPS(t *testing.T) {
 	var tests = []struct {
 		value    string
@@ -101,6 +139,17 @@ func TestParseDecimal(t *testing.T) {
 	}
 }
 
+func TestLatitudeLongitude(t *testing.T) {
+	assert.Equal(t, "4807.0380 N", Latitude(48.1173).String())
+	assert.Equal(t, "3356.4650 S", Latitude(-33.941083333).String())
+	assert.Equal(t, "01131.0000 E", Longitude(11.516666667).String())
+	assert.Equal(t, "15124.5567 W", Longitude(-151.409278333).String())
+	assert.True(t, Latitude(-90).Valid())
+	assert.False(t, Latitude(90.5).Valid())
+	assert.True(t, Longitude(180).Valid())
+	assert.False(t, Longitude(-180.5).Valid())
+}
+
 func TestLatLongPrint(t *testing.T) {
 	var tests = []struct {
 		value float64
@@ -142,6 +191,7 @@ func TestTimeParse(t *testing.T) {
 		{"", Time{}, true},
 		{"112233.123", Time{true, 11, 22, 33, 123}, true},
 		{"010203.04", Time{true, 1, 2, 3, 40}, true},
+		{"235960", Time{true, 23, 59, 60, 0}, true},
 		{"10203.04", Time{}, false},
 		{"x0u2xd", Time{}, false},
 		{"xx2233.123", Time{}, false},
@@ -179,6 +229,31 @@ func TestTimeString(t *testing.T) {
 	}
 }
 
+func TestTimeCompare(t *testing.T) {
+	var tests = []struct {
+		a, b   Time
+		before bool
+		after  bool
+		equal  bool
+	}{
+		{Time{true, 12, 34, 56, 0}, Time{true, 12, 34, 56, 0}, false, false, true},
+		{Time{true, 12, 34, 56, 100}, Time{true, 12, 34, 56, 200}, true, false, false},
+		{Time{true, 12, 34, 56, 999}, Time{true, 12, 34, 56, 998}, false, true, false},
+		{Time{true, 12, 34, 56, 0}, Time{true, 12, 34, 55, 999}, false, true, false},
+		{Time{true, 1, 0, 0, 0}, Time{true, 0, 59, 59, 999}, false, true, false},
+		{Time{true, 0, 0, 0, 0}, Time{}, false, false, false},
+		{Time{}, Time{}, false, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a.String()+" "+tt.b.String(), func(t *testing.T) {
+			assert.Equal(t, tt.before, tt.a.Before(tt.b))
+			assert.Equal(t, tt.after, tt.a.After(tt.b))
+			assert.Equal(t, tt.equal, tt.a.Equal(tt.b))
+			assert.Equal(t, tt.equal, tt.b.Equal(tt.a))
+		})
+	}
+}
+
 func TestDateParse(t *testing.T) {
 	datetests := []struct {
 		value    string
@@ -191,6 +266,14 @@ func TestDateParse(t *testing.T) {
 		{"xx0203", Date{}, false},
 		{"01xx03", Date{}, false},
 		{"0102xx", Date{}, false},
+		{"320203", Date{}, false},
+		{"001303", Date{}, false},
+		{"011303", Date{}, false},
+		{"310224", Date{}, false},
+		{"310424", Date{}, false},
+		{"290224", Date{true, 29, 2, 24}, true},
+		{"290223", Date{}, false},
+		{"01-203", Date{}, false},
 	}
 	for _, tt := range datetests {
 		actual, err := ParseDate(tt.value)
@@ -220,3 +303,10 @@ func TestDateString(t *testing.T) {
 		t.Fatalf("got %s expected %s", s, expected)
 	}
 }
+
+func TestDateYear(t *testing.T) {
+	assert.Equal(t, 1970, Date{true, 1, 1, 70}.Year())
+	assert.Equal(t, 1999, Date{true, 1, 1, 99}.Year())
+	assert.Equal(t, 2000, Date{true, 1, 1, 0}.Year())
+	assert.Equal(t, 2069, Date{true, 1, 1, 69}.Year())
+}
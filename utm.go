@@ -0,0 +1,98 @@
+package nmea
+
+import "math"
+
+const (
+	// wgs84SemiMajorAxis is the equatorial radius of the WGS84 ellipsoid in meters.
+	wgs84SemiMajorAxis = 6378137.0
+	// wgs84Flattening is the flattening of the WGS84 ellipsoid.
+	wgs84Flattening = 1 / 298.257223563
+	// utmScaleFactor is the scale factor on the central meridian of a UTM zone.
+	utmScaleFactor = 0.9996
+)
+
+// ToUTM converts a WGS84 position in decimal degrees into Universal Transverse
+// Mercator coordinates: the zone (1 to 60), the hemisphere ('N' or 'S'), and
+// the easting and northing in meters. The northing of the southern hemisphere
+// includes the 10000 km false northing. The Norway and Svalbard zone
+// exceptions are applied. UTM is only defined between 80°S and 84°N: ok is
+// false for a position outside that range or with a longitude outside
+// ±180°.
+func ToUTM(lat, lon float64) (zone int, hemisphere byte, easting, northing float64, ok bool) {
+	if !(lat >= -80 && lat <= 84 && lon >= -180 && lon <= 180) {
+		return 0, 0, 0, 0, false
+	}
+	zone = utmZone(lat, lon)
+	lon0 := float64(zone*6 - 183)
+
+	e2 := wgs84Flattening * (2 - wgs84Flattening)
+	e4, e6 := e2*e2, e2*e2*e2
+	ep2 := e2 / (1 - e2)
+
+	phi := lat * math.Pi / 180
+	sin, cos, tan := math.Sin(phi), math.Cos(phi), math.Tan(phi)
+	n := wgs84SemiMajorAxis / math.Sqrt(1-e2*sin*sin)
+	t := tan * tan
+	c := ep2 * cos * cos
+	a := cos * (lon - lon0) * math.Pi / 180
+	m := wgs84SemiMajorAxis * ((1-e2/4-3*e4/64-5*e6/256)*phi -
+		(3*e2/8+3*e4/32+45*e6/1024)*math.Sin(2*phi) +
+		(15*e4/256+45*e6/1024)*math.Sin(4*phi) -
+		(35*e6/3072)*math.Sin(6*phi))
+
+	easting = 500000 + utmScaleFactor*n*(a+
+		(1-t+c)*math.Pow(a, 3)/6+
+		(5-18*t+t*t+72*c-58*ep2)*math.Pow(a, 5)/120)
+	northing = utmScaleFactor * (m + n*tan*(a*a/2+
+		(5-t+9*c+4*c*c)*math.Pow(a, 4)/24+
+		(61-58*t+t*t+600*c-330*ep2)*math.Pow(a, 6)/720))
+	hemisphere = 'N'
+	if lat < 0 {
+		hemisphere = 'S'
+		northing += 10000000
+	}
+	return zone, hemisphere, easting, northing, true
+}
+
+// utmZone returns the UTM zone of the position.
+func utmZone(lat, lon float64) int {
+	zone := int(math.Floor((lon+180)/6)) + 1
+	if zone > 60 {
+		zone = 60
+	}
+	switch {
+	case lat >= 56 && lat < 64 && lon >= 3 && lon < 12:
+		return 32
+	case lat >= 72 && lat <= 84 && lon >= 0 && lon < 42:
+		// zones 32, 34 and 36 are not used in Svalbard
+		switch {
+		case lon < 9:
+			return 31
+		case lon < 21:
+			return 33
+		case lon < 33:
+			return 35
+		default:
+			return 37
+		}
+	}
+	return zone
+}
+
+// UTM returns the position of the sentence in UTM coordinates, see ToUTM.
+// ok is false if the sentence has no position.
+func (s GGA) UTM() (zone int, hemisphere byte, easting, northing float64, ok bool) {
+	if !s.FieldPresent(1) || !s.FieldPresent(3) {
+		return 0, 0, 0, 0, false
+	}
+	return ToUTM(s.Latitude, s.Longitude)
+}
+
+// UTM returns the position of the sentence in UTM coordinates, see ToUTM.
+// ok is false if the sentence has no position.
+func (s GLL) UTM() (zone int, hemisphere byte, easting, northing float64, ok bool) {
+	if !s.FieldPresent(0) || !s.FieldPresent(2) {
+		return 0, 0, 0, 0, false
+	}
+	return ToUTM(s.Latitude, s.Longitude)
+}
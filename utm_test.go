@@ -0,0 +1,90 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToUTM(t *testing.T) {
+	tests := []struct {
+		name       string
+		lat, lon   float64
+		zone       int
+		hemisphere byte
+		easting    float64
+		northing   float64
+	}{
+		{"equator on central meridian", 0, 3, 31, 'N', 500000, 0},
+		{"Aachen", 50.77535, 6.08389, 32, 'N', 294409, 5628898},
+		{"New York", 40.71435, -74.00597, 18, 'N', 583960, 4507523},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zone, hemisphere, easting, northing, ok := ToUTM(tt.lat, tt.lon)
+			assert.True(t, ok)
+			assert.Equal(t, tt.zone, zone)
+			assert.Equal(t, tt.hemisphere, hemisphere)
+			assert.InDelta(t, tt.easting, easting, 1)
+			assert.InDelta(t, tt.northing, northing, 1)
+		})
+	}
+}
+
+func TestToUTMSouthernHemisphere(t *testing.T) {
+	zoneN, _, eastingN, northingN, _ := ToUTM(40.71435, -74.00597)
+	zoneS, hemisphere, eastingS, northingS, _ := ToUTM(-40.71435, -74.00597)
+	assert.Equal(t, zoneN, zoneS)
+	assert.Equal(t, byte('S'), hemisphere)
+	assert.InDelta(t, eastingN, eastingS, 0.001)
+	assert.InDelta(t, 10000000-northingN, northingS, 0.001)
+}
+
+func TestToUTMOutOfRange(t *testing.T) {
+	for _, pos := range [][2]float64{{84.5, 10}, {-80.5, 10}, {45, 181}, {math.NaN(), 0}} {
+		_, _, _, _, ok := ToUTM(pos[0], pos[1])
+		assert.False(t, ok, "%v", pos)
+	}
+	_, _, _, _, ok := ToUTM(84, 10)
+	assert.True(t, ok)
+	_, _, _, _, ok = ToUTM(-80, 10)
+	assert.True(t, ok)
+}
+
+func TestUTMZone(t *testing.T) {
+	assert.Equal(t, 1, utmZone(0, -180))
+	assert.Equal(t, 60, utmZone(0, 180))
+	assert.Equal(t, 31, utmZone(55, 5))
+	assert.Equal(t, 32, utmZone(60, 5))
+	assert.Equal(t, 33, utmZone(78, 15))
+	assert.Equal(t, 37, utmZone(78, 40))
+}
+
+func TestGGAUTM(t *testing.T) {
+	m, err := Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51")
+	assert.NoError(t, err)
+	zone, hemisphere, _, _, ok := m.(GGA).UTM()
+	assert.True(t, ok)
+	assert.Equal(t, 56, zone)
+	assert.Equal(t, byte('S'), hemisphere)
+
+	m, err = Parse("$GPGGA,,,,,,0,00,99.99,,,,,,*48")
+	assert.NoError(t, err)
+	_, _, _, _, ok = m.(GGA).UTM()
+	assert.False(t, ok)
+}
+
+func TestGLLUTM(t *testing.T) {
+	m, err := Parse("$GPGLL,3926.7952,N,12000.5947,W,022732,A,A*58")
+	assert.NoError(t, err)
+	zone, hemisphere, _, _, ok := m.(GLL).UTM()
+	assert.True(t, ok)
+	assert.Equal(t, 10, zone)
+	assert.Equal(t, byte('N'), hemisphere)
+
+	m, err = Parse("$GPGLL,,,,,022732,V,N*62")
+	assert.NoError(t, err)
+	_, _, _, _, ok = m.(GLL).UTM()
+	assert.False(t, ok)
+}
@@ -0,0 +1,56 @@
+package nmea
+
+const (
+	// TypeVBW type for VBW sentences
+	TypeVBW = "VBW"
+	// ValidVBW data valid
+	ValidVBW = "A"
+	// InvalidVBW data invalid
+	InvalidVBW = "V"
+)
+
+// VBW is the dual ground/water speed.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_vbw_dual_groundwater_speed
+type VBW struct {
+	BaseSentence
+	LongitudinalWaterSpeed  float64 // Longitudinal water speed in knots, negative is astern
+	TransverseWaterSpeed    float64 // Transverse water speed in knots, negative is port
+	WaterSpeedStatus        string  // Water speed status, A = valid, V = invalid
+	LongitudinalGroundSpeed float64 // Longitudinal ground speed in knots, negative is astern
+	TransverseGroundSpeed   float64 // Transverse ground speed in knots, negative is port
+	GroundSpeedStatus       string  // Ground speed status, A = valid, V = invalid
+}
+
+func (s VBW) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"longitudinal_water_speed":  s.LongitudinalWaterSpeed,
+		"transverse_water_speed":    s.TransverseWaterSpeed,
+		"water_speed_status":        s.WaterSpeedStatus,
+		"longitudinal_ground_speed": s.LongitudinalGroundSpeed,
+		"transverse_ground_speed":   s.TransverseGroundSpeed,
+		"ground_speed_status":       s.GroundSpeedStatus,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newVBW constructor
+func newVBW(s BaseSentence) (VBW, error) {
+	p := NewParser(s)
+	p.AssertType(TypeVBW)
+	return VBW{
+		BaseSentence:            s,
+		LongitudinalWaterSpeed:  p.Float64(0, "longitudinal water speed"),
+		TransverseWaterSpeed:    p.Float64(1, "transverse water speed"),
+		WaterSpeedStatus:        p.EnumString(2, "water speed status", ValidVBW, InvalidVBW),
+		LongitudinalGroundSpeed: p.Float64(3, "longitudinal ground speed"),
+		TransverseGroundSpeed:   p.Float64(4, "transverse ground speed"),
+		GroundSpeedStatus:       p.EnumString(5, "ground speed status", ValidVBW, InvalidVBW),
+	}, p.Err()
+}
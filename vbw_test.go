@@ -0,0 +1,61 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var vbwtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  VBW
+}{
+	{
+		name: "good sentence",
+		raw:  "$VDVBW,11.0,02.0,A,07.5,13.3,A*50",
+		msg: VBW{
+			LongitudinalWaterSpeed:  11,
+			TransverseWaterSpeed:    2,
+			WaterSpeedStatus:        ValidVBW,
+			LongitudinalGroundSpeed: 7.5,
+			TransverseGroundSpeed:   13.3,
+			GroundSpeedStatus:       ValidVBW,
+		},
+	},
+	{
+		name: "invalid data",
+		raw:  "$VDVBW,11.0,02.0,V,07.5,13.3,V*50",
+		msg: VBW{
+			LongitudinalWaterSpeed:  11,
+			TransverseWaterSpeed:    2,
+			WaterSpeedStatus:        InvalidVBW,
+			LongitudinalGroundSpeed: 7.5,
+			TransverseGroundSpeed:   13.3,
+			GroundSpeedStatus:       InvalidVBW,
+		},
+	},
+	{
+		name: "bad water speed status",
+		raw:  "$VDVBW,11.0,02.0,X,07.5,13.3,A*49",
+		err:  "nmea: VDVBW invalid water speed status: X",
+	},
+}
+
+func TestVBW(t *testing.T) {
+	for _, tt := range vbwtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				vbw := m.(VBW)
+				vbw.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, vbw)
+			}
+		})
+	}
+}
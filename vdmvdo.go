@@ -1,5 +1,7 @@
 package nmea
 
+import "fmt"
+
 const (
 	// TypeVDM type for VDM sentences
 	TypeVDM = "VDM"
@@ -38,6 +40,12 @@ func (s VDMVDO) ToMap() (map[string]interface{}, error) {
 	return m, nil
 }
 
+// IsOwnVessel reports whether the sentence is a VDO sentence, reporting the
+// own vessel, rather than a VDM sentence received from other vessels.
+func (s VDMVDO) IsOwnVessel() bool {
+	return s.Type == TypeVDO
+}
+
 // newVDMVDO constructor
 func newVDMVDO(s BaseSentence) (VDMVDO, error) {
 	p := NewParser(s)
@@ -51,3 +59,42 @@ func newVDMVDO(s BaseSentence) (VDMVDO, error) {
 	}
 	return m, p.Err()
 }
+
+// ValidateAISPayload checks that every character of the armored payload is
+// in the 6-bit armor range and that fillBits is between 0 and 5. The same
+// checks are applied when a VDM/VDO sentence is parsed.
+func ValidateAISPayload(payload string, fillBits int) error {
+	if fillBits < 0 || fillBits > 5 {
+		return fmt.Errorf("nmea: invalid AIS fill bits: %d", fillBits)
+	}
+	for i := 0; i < len(payload); i++ {
+		if _, ok := sixBitValue(payload[i]); !ok {
+			return fmt.Errorf("nmea: invalid AIS payload character %q at offset %d", payload[i], i)
+		}
+	}
+	return nil
+}
+
+// DecodePayload de-armors the 6-bit ASCII AIS payload into a packed bit
+// buffer, 8 bits per byte with the first bit in the most significant
+// position. The last fillBits bits of the payload are dropped and the
+// unused low bits of the last byte are zero. The payload is validated
+// like ValidateAISPayload. The Payload of a parsed VDM/VDO sentence holds
+// the same bits unpacked, one per byte.
+func DecodePayload(payload string, fillBits int) ([]byte, error) {
+	if err := ValidateAISPayload(payload, fillBits); err != nil {
+		return nil, err
+	}
+	numBits := len(payload)*6 - fillBits
+	if numBits < 0 {
+		return nil, fmt.Errorf("nmea: invalid AIS fill bits: %d", fillBits)
+	}
+	buf := make([]byte, (numBits+7)/8)
+	for i := 0; i < numBits; i++ {
+		v, _ := sixBitValue(payload[i/6])
+		if v>>uint(5-i%6)&1 == 1 {
+			buf[i/8] |= 0x80 >> uint(i%8)
+		}
+	}
+	return buf, nil
+}
@@ -66,6 +66,11 @@ var vdmtests = []struct {
 		raw:  "!AIVDM,1,1,,1,000 00,0*46",
 		err:  "nmea: AIVDM invalid payload: data byte",
 	},
+	{
+		name: "Symbol between armor ranges in payload",
+		raw:  "!AIVDM,1,1,,1,00X,0*0E",
+		err:  "nmea: AIVDM invalid payload: data byte",
+	},
 	{
 		name: "Negative number of fill bits",
 		raw:  "!AIVDM,1,1,,1,000,-3*48",
@@ -100,3 +105,123 @@ func TestVDM(t *testing.T) {
 		})
 	}
 }
+
+func TestVDMVDOTalkers(t *testing.T) {
+	want := mustParseVDMVDO(t, "!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55")
+	tests := []struct {
+		raw    string
+		talker string
+		typ    string
+	}{
+		{"!BSVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*4C", "BS", TypeVDM},
+		{"!ABVDO,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*5C", "AB", TypeVDO},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			assert.NoError(t, err)
+			vdm, ok := m.(VDMVDO)
+			assert.True(t, ok)
+			assert.Equal(t, tt.talker, vdm.TalkerID())
+			assert.Equal(t, tt.typ, vdm.DataType())
+			assert.Equal(t, want.Payload, vdm.Payload)
+		})
+	}
+}
+
+func TestVDMVDOIsOwnVessel(t *testing.T) {
+	vdm := mustParseVDMVDO(t, "!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55")
+	assert.False(t, vdm.IsOwnVessel())
+	vdo := mustParseVDMVDO(t, "!ABVDO,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*5C")
+	assert.True(t, vdo.IsOwnVessel())
+}
+
+func TestValidateAISPayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  string
+		fillBits int
+		err      string
+	}{
+		{"valid payload", "13aGt0PP0jPN@9fMPKVDJgwfR>`<", 0, ""},
+		{"valid payload with fill bits", "H77nSfPh4U=<E`H4U8G;:222220", 2, ""},
+		{"character between armor ranges", "13aGX0PP", 0, `nmea: invalid AIS payload character 'X' at offset 4`},
+		{"character above armor range", "13aGt0PPx", 0, `nmea: invalid AIS payload character 'x' at offset 8`},
+		{"negative fill bits", "13aGt0PP", -1, "nmea: invalid AIS fill bits: -1"},
+		{"too many fill bits", "13aGt0PP", 6, "nmea: invalid AIS fill bits: 6"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAISPayload(tt.payload, tt.fillBits)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDecodePayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  string
+		fillBits int
+		bits     []byte
+		err      string
+	}{
+		{"single character", "w", 0, []byte{0xFC}, ""},
+		{"fill bits", "w", 2, []byte{0xF0}, ""},
+		{"spanning bytes", "0w", 0, []byte{0x03, 0xF0}, ""},
+		{"whole bytes", "0000", 0, []byte{0x00, 0x00, 0x00}, ""},
+		{"empty payload", "", 0, []byte{}, ""},
+		{"fill bits without payload", "", 2, nil, "nmea: invalid AIS fill bits: 2"},
+		{"invalid character", "13aGX0PP", 0, nil, `nmea: invalid AIS payload character 'X' at offset 4`},
+		{"too many fill bits", "13aGt0PP", 6, nil, "nmea: invalid AIS fill bits: 6"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bits, err := DecodePayload(tt.payload, tt.fillBits)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.bits, bits)
+			}
+		})
+	}
+}
+
+func TestDecodePayloadMatchesSentence(t *testing.T) {
+	m, err := Parse("!AIVDM,1,1,,A,13aGt0PP0jPN@9fMPKVDJgwfR>`<,0*55")
+	assert.NoError(t, err)
+	unpacked := m.(VDMVDO).Payload
+
+	bits, err := DecodePayload("13aGt0PP0jPN@9fMPKVDJgwfR>`<", 0)
+	assert.NoError(t, err)
+	assert.Len(t, bits, (len(unpacked)+7)/8)
+	for i, b := range unpacked {
+		assert.Equal(t, b, bits[i/8]>>uint(7-i%8)&1, "bit %d", i)
+	}
+}
+
+func TestVDMVDOChecksum(t *testing.T) {
+	for _, raw := range []string{
+		"!AIVDM,1,1,,B,177KQJ5000G?tO`K>RA1wUbN0TKH,0*5C",
+		"!AIVDM,1,1,,A,15RTgt0PAso;90TKcjM8h6g208CQ,0*4A",
+		"!AIVDM,1,1,,B,181:Kjh01ewHFRPDK1s3IRcn06sd,0*08",
+		"!AIVDM,1,1,,A,14eG;o@034o8sd<L9i:a;WF>062D,0*7D",
+		"!AIVDO,1,1,,,B>qc:003wk?8mP=18D3Q3wgTiT;T,0*13",
+		"!AIVDM,2,1,3,B,55P5TL01VIaAL@7WKO@mBplU@<PDhh000000001S;AJ::4A80?4i@E53,0*3E",
+		"!AIVDM,2,2,3,B,1@0000000000000,2*55",
+	} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := Parse(raw)
+			assert.NoError(t, err)
+		})
+	}
+
+	// the fill bits are covered by the checksum
+	_, err := Parse("!AIVDM,2,2,3,B,1@0000000000000,0*55")
+	assert.EqualError(t, err, "nmea: sentence checksum mismatch [57 != 55]")
+}
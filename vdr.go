@@ -0,0 +1,65 @@
+package nmea
+
+import "math"
+
+const (
+	// TypeVDR type for VDR sentences
+	TypeVDR = "VDR"
+)
+
+// VDR is the set and drift of the current.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_vdr_set_and_drift
+type VDR struct {
+	BaseSentence
+	SetTrue         float64 // Direction of the current in degrees true
+	SetTrueUnit     string  // T = true
+	SetMagnetic     float64 // Direction of the current in degrees magnetic
+	SetMagneticUnit string  // M = magnetic
+	Drift           float64 // Speed of the current in knots
+	DriftUnit       string  // N = knots
+}
+
+func (s VDR) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"set_true":          s.SetTrue,
+		"set_true_unit":     s.SetTrueUnit,
+		"set_magnetic":      s.SetMagnetic,
+		"set_magnetic_unit": s.SetMagneticUnit,
+		"drift":             s.Drift,
+		"drift_unit":        s.DriftUnit,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// NorthEastComponents decomposes the drift into its northward and eastward
+// components in knots. The true set is used when present, else the magnetic one.
+func (s VDR) NorthEastComponents() (north, east float64) {
+	set := s.SetMagnetic
+	if s.FieldPresent(0) {
+		set = s.SetTrue
+	}
+	rad := set * math.Pi / 180
+	return s.Drift * math.Cos(rad), s.Drift * math.Sin(rad)
+}
+
+// newVDR constructor
+func newVDR(s BaseSentence) (VDR, error) {
+	p := NewParser(s)
+	p.AssertType(TypeVDR)
+	return VDR{
+		BaseSentence:    s,
+		SetTrue:         p.Float64(0, "true set"),
+		SetTrueUnit:     p.EnumString(1, "true set unit", "T"),
+		SetMagnetic:     p.Float64(2, "magnetic set"),
+		SetMagneticUnit: p.EnumString(3, "magnetic set unit", "M"),
+		Drift:           p.Float64(4, "drift"),
+		DriftUnit:       p.EnumString(5, "drift unit", "N"),
+	}, p.Err()
+}
@@ -0,0 +1,64 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var vdrtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  VDR
+}{
+	{
+		name: "good sentence",
+		raw:  "$IIVDR,45.0,T,40.0,M,2.0,N*3E",
+		msg: VDR{
+			SetTrue:         45,
+			SetTrueUnit:     "T",
+			SetMagnetic:     40,
+			SetMagneticUnit: "M",
+			Drift:           2,
+			DriftUnit:       "N",
+		},
+	},
+	{
+		name: "bad drift unit",
+		raw:  "$IIVDR,45.0,T,40.0,M,2.0,K*3B",
+		err:  "nmea: IIVDR invalid drift unit: K",
+	},
+}
+
+func TestVDR(t *testing.T) {
+	for _, tt := range vdrtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				vdr := m.(VDR)
+				vdr.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, vdr)
+			}
+		})
+	}
+}
+
+func TestVDRNorthEastComponents(t *testing.T) {
+	m, err := Parse("$IIVDR,45.0,T,40.0,M,2.0,N*3E")
+	assert.NoError(t, err)
+	north, east := m.(VDR).NorthEastComponents()
+	assert.InDelta(t, math.Sqrt2, north, 0.000001)
+	assert.InDelta(t, math.Sqrt2, east, 0.000001)
+
+	m, err = Parse("$IIVDR,,T,40.0,M,2.0,N*21")
+	assert.NoError(t, err)
+	north, east = m.(VDR).NorthEastComponents()
+	assert.InDelta(t, 2*math.Cos(40*math.Pi/180), north, 0.000001)
+	assert.InDelta(t, 2*math.Sin(40*math.Pi/180), east, 0.000001)
+}
@@ -57,3 +57,15 @@ func newVHW(s BaseSentence) (VHW, error) {
 	}
 	return m, p.Err()
 }
+
+// HasTrueHeading reports whether the true heading field was reported,
+// so that an empty field is not mistaken for a 0° heading.
+func (s VHW) HasTrueHeading() bool {
+	return s.FieldPresent(0)
+}
+
+// HasMagneticHeading reports whether the magnetic heading field was reported,
+// so that an empty field is not mistaken for a 0° heading.
+func (s VHW) HasMagneticHeading() bool {
+	return s.FieldPresent(2)
+}
@@ -45,3 +45,32 @@ func Test_newVHW(t *testing.T) {
 		})
 	}
 }
+
+func TestVHWHeadingPresence(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         string
+		hasTrue     bool
+		hasMagnetic bool
+	}{
+		{"true only", makeSentence("$IIVHW,245.1,T,,M,5.5,N,10.2,K"), true, false},
+		{"true zero heading", makeSentence("$IIVHW,0.0,T,,M,5.5,N,10.2,K"), true, false},
+		{"magnetic only", makeSentence("$IIVHW,,T,240.5,M,5.5,N,10.2,K"), false, true},
+		{"both", makeSentence("$IIVHW,245.1,T,240.5,M,5.5,N,10.2,K"), true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if err != nil {
+				t.Fatalf("newVHW() error = %v", err)
+			}
+			msg := m.(VHW)
+			if got := msg.HasTrueHeading(); got != tt.hasTrue {
+				t.Errorf("HasTrueHeading() = %v, want %v", got, tt.hasTrue)
+			}
+			if got := msg.HasMagneticHeading(); got != tt.hasMagnetic {
+				t.Errorf("HasMagneticHeading() = %v, want %v", got, tt.hasMagnetic)
+			}
+		})
+	}
+}
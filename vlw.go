@@ -0,0 +1,63 @@
+package nmea
+
+const (
+	// TypeVLW type for VLW sentences
+	TypeVLW = "VLW"
+)
+
+// VLW is the distance traveled through water and over ground.
+// The ground distance fields were added in NMEA 3.0 and are left
+// empty when the sentence does not carry them.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_vlw_distance_traveled_through_water
+type VLW struct {
+	BaseSentence
+	TotalInWater           float64 // Total cumulative water distance
+	TotalInWaterUnit       string  // N = nautical miles
+	SinceResetInWater      float64 // Water distance since reset
+	SinceResetInWaterUnit  string  // N = nautical miles
+	TotalOnGround          float64 // Total cumulative ground distance
+	TotalOnGroundUnit      string  // N = nautical miles
+	SinceResetOnGround     float64 // Ground distance since reset
+	SinceResetOnGroundUnit string  // N = nautical miles
+}
+
+func (s VLW) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"total_in_water":             s.TotalInWater,
+		"total_in_water_unit":        s.TotalInWaterUnit,
+		"since_reset_in_water":       s.SinceResetInWater,
+		"since_reset_in_water_unit":  s.SinceResetInWaterUnit,
+		"total_on_ground":            s.TotalOnGround,
+		"total_on_ground_unit":       s.TotalOnGroundUnit,
+		"since_reset_on_ground":      s.SinceResetOnGround,
+		"since_reset_on_ground_unit": s.SinceResetOnGroundUnit,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newVLW constructor
+func newVLW(s BaseSentence) (VLW, error) {
+	p := NewParser(s)
+	p.AssertType(TypeVLW)
+	m := VLW{
+		BaseSentence:          s,
+		TotalInWater:          p.Float64(0, "total cumulative water distance"),
+		TotalInWaterUnit:      p.String(1, "total cumulative water distance unit"),
+		SinceResetInWater:     p.Float64(2, "water distance since reset"),
+		SinceResetInWaterUnit: p.String(3, "water distance since reset unit"),
+	}
+	if len(s.Fields) > 4 {
+		m.TotalOnGround = p.Float64(4, "total cumulative ground distance")
+		m.TotalOnGroundUnit = p.String(5, "total cumulative ground distance unit")
+		m.SinceResetOnGround = p.Float64(6, "ground distance since reset")
+		m.SinceResetOnGroundUnit = p.String(7, "ground distance since reset unit")
+	}
+	return m, p.Err()
+}
@@ -0,0 +1,61 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var vlwtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  VLW
+}{
+	{
+		name: "good sentence",
+		raw:  "$VWVLW,2.8,N,1.2,N*45",
+		msg: VLW{
+			TotalInWater:          2.8,
+			TotalInWaterUnit:      "N",
+			SinceResetInWater:     1.2,
+			SinceResetInWaterUnit: "N",
+		},
+	},
+	{
+		name: "good sentence with ground distance",
+		raw:  "$VWVLW,2.8,N,1.2,N,3.5,N,1.6,N*44",
+		msg: VLW{
+			TotalInWater:           2.8,
+			TotalInWaterUnit:       "N",
+			SinceResetInWater:      1.2,
+			SinceResetInWaterUnit:  "N",
+			TotalOnGround:          3.5,
+			TotalOnGroundUnit:      "N",
+			SinceResetOnGround:     1.6,
+			SinceResetOnGroundUnit: "N",
+		},
+	},
+	{
+		name: "invalid total cumulative water distance",
+		raw:  "$VWVLW,x,N,1.2,N*19",
+		err:  "nmea: VWVLW invalid total cumulative water distance: x",
+	},
+}
+
+func TestVLW(t *testing.T) {
+	for _, tt := range vlwtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				vlw := m.(VLW)
+				vlw.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, vlw)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package nmea
 
+import "math"
+
 const (
 	// TypeVTG type for VTG sentences
 	TypeVTG = "VTG"
@@ -45,3 +47,30 @@ func newVTG(s BaseSentence) (VTG, error) {
 		GroundSpeedKPH:   p.Float64(6, "ground speed (km/h)"),
 	}, p.Err()
 }
+
+// IsStationary reports whether the ground speed is below the given threshold
+// in knots. The km/h speed is used when the knots field is not reported.
+// A small threshold filters out the jitter receivers report while at rest.
+func (s VTG) IsStationary(thresholdKnots float64) bool {
+	speed := s.GroundSpeedKnots
+	if speed == 0 && s.GroundSpeedKPH != 0 {
+		speed = s.GroundSpeedKPH / 1.852
+	}
+	return speed < thresholdKnots
+}
+
+// EstimatedMagneticVariation returns the difference between the true and
+// magnetic track (true - magnetic) in degrees, normalized to (-180, 180],
+// positive for an easterly variation. ok is false unless both tracks are present.
+func (s VTG) EstimatedMagneticVariation() (variation float64, ok bool) {
+	if !s.FieldPresent(0) || !s.FieldPresent(2) {
+		return 0, false
+	}
+	variation = math.Mod(s.TrueTrack-s.MagneticTrack, 360)
+	if variation > 180 {
+		variation -= 360
+	} else if variation <= -180 {
+		variation += 360
+	}
+	return variation, true
+}
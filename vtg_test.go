@@ -45,3 +45,47 @@ func TestVTG(t *testing.T) {
 		})
 	}
 }
+
+func TestVTGIsStationary(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        VTG
+		threshold  float64
+		stationary bool
+	}{
+		{"below threshold", VTG{GroundSpeedKnots: 0.05, GroundSpeedKPH: 0.09}, 0.1, true},
+		{"at threshold", VTG{GroundSpeedKnots: 0.1, GroundSpeedKPH: 0.19}, 0.1, false},
+		{"above threshold", VTG{GroundSpeedKnots: 0.2, GroundSpeedKPH: 0.37}, 0.1, false},
+		{"zero speed", VTG{}, 0.1, true},
+		{"km/h only below threshold", VTG{GroundSpeedKPH: 0.1}, 0.1, true},
+		{"km/h only above threshold", VTG{GroundSpeedKPH: 0.2}, 0.1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.stationary, tt.msg.IsStationary(tt.threshold))
+		})
+	}
+}
+
+func TestVTGEstimatedMagneticVariation(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       string
+		variation float64
+		ok        bool
+	}{
+		{"both present", "$GPVTG,360.0,T,348.7,M,000.0,N,000.0,K*43", 11.3, true},
+		{"across north", "$GPVTG,5.0,T,355.0,M,000.0,N,000.0,K*48", 10, true},
+		{"true missing", "$GPVTG,,T,348.7,M,000.0,N,000.0,K*68", 0, false},
+		{"magnetic missing", "$GPVTG,360.0,T,,M,000.0,N,000.0,K*65", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			assert.NoError(t, err)
+			variation, ok := m.(VTG).EstimatedMagneticVariation()
+			assert.InDelta(t, tt.variation, variation, 0.000001)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package nmea
+
+const (
+	// TypeVWR type for VWR sentences
+	TypeVWR = "VWR"
+	// LeftVWR wind from the left of the bow
+	LeftVWR = "L"
+	// RightVWR wind from the right of the bow
+	RightVWR = "R"
+	// KnotsVWR wind speed unit
+	KnotsVWR = "N"
+	// MetersPerSecondVWR wind speed unit
+	MetersPerSecondVWR = "M"
+	// KilometersPerHourVWR wind speed unit
+	KilometersPerHourVWR = "K"
+)
+
+// VWR is the relative wind speed and angle, superseded by MWV.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_vwr_relative_wind_speed_and_angle
+type VWR struct {
+	BaseSentence
+	MeasuredAngle        float64 // Measured wind angle relative to the bow in degrees, 0 to 180
+	MeasuredDirectionBow string  // Side of the bow the wind comes from, L = left, R = right
+	SpeedKnots           float64 // Measured wind speed in knots
+	SpeedKnotsUnit       string  // N = knots
+	SpeedMeters          float64 // Measured wind speed in meters per second
+	SpeedMetersUnit      string  // M = meters per second
+	SpeedKmh             float64 // Measured wind speed in kilometers per hour
+	SpeedKmhUnit         string  // K = kilometers per hour
+}
+
+func (s VWR) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"measured_angle":         s.MeasuredAngle,
+		"measured_direction_bow": s.MeasuredDirectionBow,
+		"speed_knots":            s.SpeedKnots,
+		"speed_knots_unit":       s.SpeedKnotsUnit,
+		"speed_meters":           s.SpeedMeters,
+		"speed_meters_unit":      s.SpeedMetersUnit,
+		"speed_kmh":              s.SpeedKmh,
+		"speed_kmh_unit":         s.SpeedKmhUnit,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newVWR constructor
+func newVWR(s BaseSentence) (VWR, error) {
+	p := NewParser(s)
+	p.AssertType(TypeVWR)
+	return VWR{
+		BaseSentence:         s,
+		MeasuredAngle:        p.Float64(0, "measured angle"),
+		MeasuredDirectionBow: p.EnumString(1, "measured direction bow", LeftVWR, RightVWR),
+		SpeedKnots:           p.Float64(2, "wind speed (knots)"),
+		SpeedKnotsUnit:       p.EnumString(3, "wind speed (knots) unit", KnotsVWR),
+		SpeedMeters:          p.Float64(4, "wind speed (m/s)"),
+		SpeedMetersUnit:      p.EnumString(5, "wind speed (m/s) unit", MetersPerSecondVWR),
+		SpeedKmh:             p.Float64(6, "wind speed (km/h)"),
+		SpeedKmhUnit:         p.EnumString(7, "wind speed (km/h) unit", KilometersPerHourVWR),
+	}, p.Err()
+}
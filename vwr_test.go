@@ -0,0 +1,51 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var vwrtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  VWR
+}{
+	{
+		name: "good sentence",
+		raw:  "$IIVWR,045.0,L,12.6,N,6.5,M,23.3,K*52",
+		msg: VWR{
+			MeasuredAngle:        45,
+			MeasuredDirectionBow: LeftVWR,
+			SpeedKnots:           12.6,
+			SpeedKnotsUnit:       KnotsVWR,
+			SpeedMeters:          6.5,
+			SpeedMetersUnit:      MetersPerSecondVWR,
+			SpeedKmh:             23.3,
+			SpeedKmhUnit:         KilometersPerHourVWR,
+		},
+	},
+	{
+		name: "bad direction",
+		raw:  "$IIVWR,045.0,X,12.6,N,6.5,M,23.3,K*46",
+		err:  "nmea: IIVWR invalid measured direction bow: X",
+	},
+}
+
+func TestVWR(t *testing.T) {
+	for _, tt := range vwrtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				vwr := m.(VWR)
+				vwr.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, vwr)
+			}
+		})
+	}
+}
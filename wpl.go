@@ -15,8 +15,8 @@ type WPL struct {
 
 func (s WPL) ToMap() (map[string]interface{}, error) {
 	m := map[string]interface{}{
-		"latitude":  s.Latitude,
-		"longitude": s.Longitude,
+		"latitude":  roundCoordinate(s.Latitude),
+		"longitude": roundCoordinate(s.Longitude),
 		"ident":     s.Ident,
 	}
 	bm, err := s.BaseSentence.toMap()
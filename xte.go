@@ -0,0 +1,67 @@
+package nmea
+
+const (
+	// TypeXTE type for XTE sentences
+	TypeXTE = "XTE"
+	// ValidXTE data valid
+	ValidXTE = "A"
+	// InvalidXTE data invalid
+	InvalidXTE = "V"
+	// LeftXTE steer left to correct the cross track error
+	LeftXTE = "L"
+	// RightXTE steer right to correct the cross track error
+	RightXTE = "R"
+	// NauticalMilesXTE cross track error in nautical miles
+	NauticalMilesXTE = "N"
+	// KilometersXTE cross track error in kilometers
+	KilometersXTE = "K"
+)
+
+// XTE is the measured cross track error.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_xte_cross_track_error_measured
+type XTE struct {
+	BaseSentence
+	StatusGeneral            string  // Status, A = valid, V = Loran-C blink or SNR warning
+	StatusLock               string  // Status, A = valid, V = Loran-C cycle lock warning
+	CrossTrackErrorMagnitude float64 // Magnitude of the cross track error
+	DirectionToSteer         string  // Direction to steer, L = left, R = right
+	CrossTrackUnits          string  // Cross track error units, N = nautical miles, K = kilometers
+	FAAMode                  string  // FAA mode indicator (NMEA 2.3 and later), empty if not sent
+}
+
+func (s XTE) ToMap() (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"status_general":              s.StatusGeneral,
+		"status_lock":                 s.StatusLock,
+		"cross_track_error_magnitude": s.CrossTrackErrorMagnitude,
+		"direction_to_steer":          s.DirectionToSteer,
+		"cross_track_units":           s.CrossTrackUnits,
+		"faa_mode":                    s.FAAMode,
+	}
+	bm, err := s.BaseSentence.toMap()
+	if err != nil {
+		return m, err
+	}
+	for k, v := range bm {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// newXTE constructor
+func newXTE(s BaseSentence) (XTE, error) {
+	p := NewParser(s)
+	p.AssertType(TypeXTE)
+	m := XTE{
+		BaseSentence:             s,
+		StatusGeneral:            p.EnumString(0, "general status", ValidXTE, InvalidXTE),
+		StatusLock:               p.EnumString(1, "lock status", ValidXTE, InvalidXTE),
+		CrossTrackErrorMagnitude: p.Float64(2, "cross track error magnitude"),
+		DirectionToSteer:         p.EnumString(3, "direction to steer", LeftXTE, RightXTE),
+		CrossTrackUnits:          p.EnumString(4, "cross track units", NauticalMilesXTE, KilometersXTE),
+	}
+	if len(m.Fields) > 5 {
+		m.FAAMode = p.EnumString(5, "FAA mode", AutonomousGNS, DifferentialGNS, EstimatedGNS, ManualGNS, SimulatorGNS, NoFixGNS, PreciseGNS, RealTimeKinematicGNS, FloatRTKGNS)
+	}
+	return m, p.Err()
+}
@@ -0,0 +1,75 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var xtetests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  XTE
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPXTE,A,A,0.67,L,N*6F",
+		msg: XTE{
+			StatusGeneral:            ValidXTE,
+			StatusLock:               ValidXTE,
+			CrossTrackErrorMagnitude: 0.67,
+			DirectionToSteer:         LeftXTE,
+			CrossTrackUnits:          NauticalMilesXTE,
+		},
+	},
+	{
+		name: "good sentence with FAA mode",
+		raw:  "$GPXTE,A,A,0.67,L,N,D*07",
+		msg: XTE{
+			StatusGeneral:            ValidXTE,
+			StatusLock:               ValidXTE,
+			CrossTrackErrorMagnitude: 0.67,
+			DirectionToSteer:         LeftXTE,
+			CrossTrackUnits:          NauticalMilesXTE,
+			FAAMode:                  DifferentialGNS,
+		},
+	},
+	{
+		name: "no cross track error",
+		raw:  "$GPXTE,V,V,,,N,N*5E",
+		msg: XTE{
+			StatusGeneral:   InvalidXTE,
+			StatusLock:      InvalidXTE,
+			CrossTrackUnits: NauticalMilesXTE,
+			FAAMode:         NoFixGNS,
+		},
+	},
+	{
+		name: "bad direction to steer",
+		raw:  "$GPXTE,A,A,0.67,X,N*7B",
+		err:  "nmea: GPXTE invalid direction to steer: X",
+	},
+	{
+		name: "bad units",
+		raw:  "$GPXTE,A,A,0.67,L,Z*7B",
+		err:  "nmea: GPXTE invalid cross track units: Z",
+	},
+}
+
+func TestXTE(t *testing.T) {
+	for _, tt := range xtetests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				xte := m.(XTE)
+				xte.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, xte)
+			}
+		})
+	}
+}
@@ -1,5 +1,10 @@
 package nmea
 
+import (
+	"strings"
+	"time"
+)
+
 const (
 	// TypeZDA type for ZDA sentences
 	TypeZDA = "ZDA"
@@ -36,6 +41,46 @@ func (s ZDA) ToMap() (map[string]interface{}, error) {
 	return m, nil
 }
 
+// DateTime returns the UTC timestamp of the sentence.
+// The zero time.Time is returned if the time is invalid or the date is missing.
+func (s ZDA) DateTime() time.Time {
+	if !s.Time.Valid || s.Year == 0 || s.Month == 0 || s.Day == 0 {
+		return time.Time{}
+	}
+	t := s.Time
+	return time.Date(int(s.Year), time.Month(s.Month), int(s.Day), t.Hour, t.Minute, t.Second, t.Millisecond*int(time.Millisecond), time.UTC)
+}
+
+// LocalDateTime returns the timestamp of the sentence in its local time zone,
+// the same instant as DateTime. The offset minutes take the sign of the
+// offset hours, e.g. -05,30 is 5h30 behind UTC.
+// The zero time.Time is returned if the time is invalid or the date is missing.
+func (s ZDA) LocalDateTime() time.Time {
+	t := s.DateTime()
+	if t.IsZero() {
+		return t
+	}
+	offset := s.OffsetHours*3600 + s.OffsetMinutes*60
+	if s.OffsetHours < 0 || strings.HasPrefix(s.field(4), "-") {
+		offset = s.OffsetHours*3600 - s.OffsetMinutes*60
+	}
+	return t.In(time.FixedZone("", int(offset)))
+}
+
+// Encode formats the sentence into a checksummed raw sentence.
+// Numeric fields keep the width and decimals they were parsed with.
+func (s ZDA) Encode() (string, error) {
+	return Encode([]string{
+		s.Prefix(),
+		formatTimeAs(s.Time, s.field(0)),
+		formatIntAs(s.Day, s.field(1)),
+		formatIntAs(s.Month, s.field(2)),
+		formatIntAs(s.Year, s.field(3)),
+		formatIntAs(s.OffsetHours, s.field(4)),
+		formatIntAs(s.OffsetMinutes, s.field(5)),
+	})
+}
+
 // newZDA constructor
 func newZDA(s BaseSentence) (ZDA, error) {
 	p := NewParser(s)
@@ -2,6 +2,7 @@ package nmea
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -53,3 +54,36 @@ func TestZDA(t *testing.T) {
 		})
 	}
 }
+
+func TestZDADateTimeLeapSecond(t *testing.T) {
+	m, err := Parse("$GPZDA,235960.50,31,12,2016,00,00*6C")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2017, time.January, 1, 0, 0, 0, 500*int(time.Millisecond), time.UTC), m.(ZDA).DateTime())
+	assert.True(t, ZDA{}.DateTime().IsZero())
+}
+
+func TestZDALocalDateTime(t *testing.T) {
+	m, err := Parse("$GPZDA,172809,12,7,1996,-05,30*55")
+	assert.NoError(t, err)
+	zda := m.(ZDA)
+	local := zda.LocalDateTime()
+	assert.True(t, local.Equal(zda.DateTime()))
+	assert.Equal(t, "1996-07-12T11:58:09-05:30", local.Format(time.RFC3339))
+	assert.True(t, ZDA{}.LocalDateTime().IsZero())
+}
+
+func TestZDAEncode(t *testing.T) {
+	for _, raw := range []string{
+		"$GPZDA,172809.456,12,07,1996,00,00*57",
+		"$GPZDA,172809,12,7,1996,-05,30*55",
+		"$GPZDA,172809,12,07,1996,,*4E",
+	} {
+		t.Run(raw, func(t *testing.T) {
+			m, err := Parse(raw)
+			assert.NoError(t, err)
+			encoded, err := m.(ZDA).Encode()
+			assert.NoError(t, err)
+			assert.Equal(t, raw, encoded)
+		})
+	}
+}